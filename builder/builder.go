@@ -0,0 +1,206 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Builder builds geometries of one coordinate type point by point.
+//
+// Points are added to the current part, Close finishes the current line or ring
+// and ClosePolygon finishes the current polygon. The first error is kept and returned by Build methods,
+// so calls can be chained:
+//
+//	polygon, err := builder.New(geometry.XY).
+//		Point(0, 0).Point(0, 1).Point(1, 1).Point(0, 0).
+//		BuildPolygon()
+type Builder struct {
+	ct       geometry.CoordinateType
+	points   []*geometry.Point
+	lines    [][]*geometry.Point
+	polygons [][][]*geometry.Point
+	err      error
+}
+
+// New returns Builder of geometries with the coordinate type
+func New(ct geometry.CoordinateType) *Builder {
+	return &Builder{ct: ct}
+}
+
+// Point adds point to the current part, coordinates are in the order of wkt for the coordinate type
+func (b *Builder) Point(coords ...float64) *Builder {
+	if b.err != nil {
+		return b
+	}
+
+	point, err := newPoint(b.ct, coords)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.points = append(b.points, point)
+	return b
+}
+
+// Close finishes the current line or ring
+func (b *Builder) Close() *Builder {
+	if len(b.points) > 0 {
+		b.lines = append(b.lines, b.points)
+		b.points = nil
+	}
+	return b
+}
+
+// ClosePolygon finishes the current polygon
+func (b *Builder) ClosePolygon() *Builder {
+	b.Close()
+	if len(b.lines) > 0 {
+		b.polygons = append(b.polygons, b.lines)
+		b.lines = nil
+	}
+	return b
+}
+
+// Err returns the first error occurred while building
+func (b *Builder) Err() error {
+	return b.err
+}
+
+// BuildPoint returns the only added point
+func (b *Builder) BuildPoint() (*geometry.Point, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if len(b.points) != 1 || len(b.lines) > 0 || len(b.polygons) > 0 {
+		return nil, fmt.Errorf("%w: expected one point", ErrInvalidCoordinates)
+	}
+	return b.points[0], nil
+}
+
+// BuildMultiPoint returns multipoint of the added points
+func (b *Builder) BuildMultiPoint() (*geometry.MultiPoint, error) {
+	points, err := b.onePart()
+	if err != nil {
+		return nil, err
+	}
+	return &geometry.MultiPoint{Type: b.ct, Points: points}, nil
+}
+
+// BuildLineString returns line string of the added points
+func (b *Builder) BuildLineString() (*geometry.LineString, error) {
+	points, err := b.onePart()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateLine(points); err != nil {
+		return nil, err
+	}
+	return &geometry.LineString{Type: b.ct, Points: points}, nil
+}
+
+// BuildCircularString returns circular string of the added points
+func (b *Builder) BuildCircularString() (*geometry.CircularString, error) {
+	points, err := b.onePart()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCircularString(points); err != nil {
+		return nil, err
+	}
+	return &geometry.CircularString{Type: b.ct, Points: points}, nil
+}
+
+// BuildMultiLineString returns multilinestring of the added lines
+func (b *Builder) BuildMultiLineString() (*geometry.MultiLineString, error) {
+	lines, err := b.onePolygon()
+	if err != nil {
+		return nil, err
+	}
+
+	multiLineString := &geometry.MultiLineString{Type: b.ct}
+	for i, points := range lines {
+		if err := validateLine(points); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+		multiLineString.Lines = append(multiLineString.Lines, &geometry.LineString{Type: b.ct, Points: points})
+	}
+	return multiLineString, nil
+}
+
+// BuildPolygon returns polygon of the added rings
+func (b *Builder) BuildPolygon() (*geometry.Polygon, error) {
+	rings, err := b.onePolygon()
+	if err != nil {
+		return nil, err
+	}
+	return b.polygon(rings)
+}
+
+// BuildMultiPolygon returns multipolygon of the added polygons
+func (b *Builder) BuildMultiPolygon() (*geometry.MultiPolygon, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	b.ClosePolygon()
+
+	multiPolygon := &geometry.MultiPolygon{Type: b.ct}
+	for i, rings := range b.polygons {
+		polygon, err := b.polygon(rings)
+		if err != nil {
+			return nil, fmt.Errorf("polygon %d: %w", i, err)
+		}
+		multiPolygon.Polygons = append(multiPolygon.Polygons, polygon)
+	}
+
+	if len(multiPolygon.Polygons) == 0 {
+		return nil, fmt.Errorf("%w: no polygons", ErrNoGeometries)
+	}
+	return multiPolygon, nil
+}
+
+func (b *Builder) polygon(rings [][]*geometry.Point) (*geometry.Polygon, error) {
+	polygon := &geometry.Polygon{Type: b.ct, Rings: make([]*geometry.LinearRing, 0, len(rings))}
+	for i, points := range rings {
+		ring := &geometry.LinearRing{Type: b.ct, Points: points}
+		if err := validateRing(ring); err != nil {
+			return nil, fmt.Errorf("ring %d: %w", i, err)
+		}
+		polygon.Rings = append(polygon.Rings, ring)
+	}
+	return polygon, nil
+}
+
+// onePart returns points of the only part
+func (b *Builder) onePart() ([]*geometry.Point, error) {
+	lines, err := b.onePolygon()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) != 1 {
+		return nil, fmt.Errorf("%w: expected one part, got %d", ErrInvalidCoordinates, len(lines))
+	}
+	return lines[0], nil
+}
+
+// onePolygon returns lines of the only polygon
+func (b *Builder) onePolygon() ([][]*geometry.Point, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	b.ClosePolygon()
+
+	if len(b.polygons) == 0 {
+		return nil, fmt.Errorf("%w: no points", ErrNoGeometries)
+	}
+
+	if len(b.polygons) != 1 {
+		return nil, fmt.Errorf("%w: expected one polygon, got %d", ErrInvalidCoordinates, len(b.polygons))
+	}
+	return b.polygons[0], nil
+}
@@ -0,0 +1,219 @@
+package builder_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/builder"
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func TestBuilder(t *testing.T) {
+	square := func(ct geometry.CoordinateType) *geometry.LinearRing {
+		return &geometry.LinearRing{
+			Type: ct,
+			Points: []*geometry.Point{
+				{X: 0, Y: 0, Type: ct},
+				{X: 0, Y: 1, Type: ct},
+				{X: 1, Y: 1, Type: ct},
+				{X: 1, Y: 0, Type: ct},
+				{X: 0, Y: 0, Type: ct},
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Build    func() (geometry.Geometry, error)
+		Expected geometry.Geometry
+		Error    error
+	}{
+		{
+			Name:     "MakePoint",
+			Build:    func() (geometry.Geometry, error) { return builder.MakePoint(1, 2, 3) },
+			Expected: &geometry.Point{X: 1, Y: 2, Z: 3, Type: geometry.XYZ},
+		},
+		{
+			Name:     "MakePointM",
+			Build:    func() (geometry.Geometry, error) { return builder.MakePointM(1, 2, 3) },
+			Expected: &geometry.Point{X: 1, Y: 2, M: 3, Type: geometry.XYM},
+		},
+		{
+			Name:  "MakePoint with one coordinate",
+			Build: func() (geometry.Geometry, error) { return builder.MakePoint(1) },
+			Error: builder.ErrInvalidCoordinates,
+		},
+		{
+			Name: "MakeLine",
+			Build: func() (geometry.Geometry, error) {
+				return builder.MakeLine(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}, &geometry.Point{X: 3, Y: 4, Type: geometry.XY})
+			},
+			Expected: &geometry.LineString{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {X: 3, Y: 4, Type: geometry.XY}},
+			},
+		},
+		{
+			Name: "MakeLine with mixed coordinate types",
+			Build: func() (geometry.Geometry, error) {
+				return builder.MakeLine(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}, &geometry.Point{X: 3, Y: 4, Type: geometry.XYM})
+			},
+			Error: builder.ErrCoordinateTypeMismatch,
+		},
+		{
+			Name: "MakePolygon",
+			Build: func() (geometry.Geometry, error) {
+				return builder.MakePolygon(square(geometry.XY).LineString())
+			},
+			Expected: &geometry.Polygon{Type: geometry.XY, Rings: []*geometry.LinearRing{square(geometry.XY)}},
+		},
+		{
+			Name: "MakePolygon with unclosed ring",
+			Build: func() (geometry.Geometry, error) {
+				ring := square(geometry.XY)
+				ring.Points[4].X = 5
+				return builder.MakePolygon(ring.LineString())
+			},
+			Error: builder.ErrRingNotClosed,
+		},
+		{
+			Name:  "MakePolygon without shell",
+			Build: func() (geometry.Geometry, error) { return builder.MakePolygon(nil) },
+			Error: builder.ErrNoGeometries,
+		},
+		{
+			Name:     "MakeEnvelope",
+			Build:    func() (geometry.Geometry, error) { return builder.MakeEnvelope(0, 0, 1, 1) },
+			Expected: &geometry.Polygon{Type: geometry.XY, Rings: []*geometry.LinearRing{square(geometry.XY)}},
+		},
+		{
+			Name:  "MakeEnvelope with swapped bounds",
+			Build: func() (geometry.Geometry, error) { return builder.MakeEnvelope(1, 0, 0, 1) },
+			Error: builder.ErrInvalidEnvelope,
+		},
+		{
+			Name: "Collect",
+			Build: func() (geometry.Geometry, error) {
+				return builder.Collect(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}, &geometry.Point{X: 3, Y: 4, Type: geometry.XY})
+			},
+			Expected: &geometry.MultiPoint{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {X: 3, Y: 4, Type: geometry.XY}},
+			},
+		},
+		{
+			Name: "Collect mixed types",
+			Build: func() (geometry.Geometry, error) {
+				return builder.Collect(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}, square(geometry.XY).LineString())
+			},
+			Error: builder.ErrMixedGeometryTypes,
+		},
+		{
+			Name: "Collect referenced",
+			Build: func() (geometry.Geometry, error) {
+				return builder.Collect(
+					geometry.WithSRID(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}, 4326),
+					geometry.WithSRID(&geometry.Point{X: 3, Y: 4, Type: geometry.XY}, 4326),
+				)
+			},
+			Expected: geometry.WithSRID(&geometry.MultiPoint{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {X: 3, Y: 4, Type: geometry.XY}},
+			}, 4326),
+		},
+		{
+			Name: "Collect different SRIDs",
+			Build: func() (geometry.Geometry, error) {
+				return builder.Collect(
+					geometry.WithSRID(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}, 4326),
+					&geometry.Point{X: 3, Y: 4, Type: geometry.XY},
+				)
+			},
+			Error: builder.ErrSRIDMismatch,
+		},
+		{
+			Name:  "Collect nothing",
+			Build: func() (geometry.Geometry, error) { return builder.Collect() },
+			Error: builder.ErrNoGeometries,
+		},
+		{
+			Name: "Collect multi points",
+			Build: func() (geometry.Geometry, error) {
+				return builder.Collect(&geometry.MultiPoint{Type: geometry.Empty}, &geometry.MultiPoint{Type: geometry.Empty})
+			},
+			Error: builder.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:  "Builder without points",
+			Build: func() (geometry.Geometry, error) { return builder.New(geometry.XY).BuildMultiPolygon() },
+			Error: builder.ErrNoGeometries,
+		},
+		{
+			Name: "Builder MultiPolygon Z",
+			Build: func() (geometry.Geometry, error) {
+				return builder.New(geometry.XYZ).
+					Point(0, 0, 0).Point(0, 1, 0).Point(1, 1, 0).Point(1, 0, 0).Point(0, 0, 0).ClosePolygon().
+					Point(0, 0, 0).Point(0, 1, 0).Point(1, 1, 0).Point(1, 0, 0).Point(0, 0, 0).
+					BuildMultiPolygon()
+			},
+			Expected: &geometry.MultiPolygon{
+				Type: geometry.XYZ,
+				Polygons: []*geometry.Polygon{
+					{Type: geometry.XYZ, Rings: []*geometry.LinearRing{square(geometry.XYZ)}},
+					{Type: geometry.XYZ, Rings: []*geometry.LinearRing{square(geometry.XYZ)}},
+				},
+			},
+		},
+		{
+			Name: "Builder MultiLineString",
+			Build: func() (geometry.Geometry, error) {
+				return builder.New(geometry.XYM).Point(0, 0, 1).Point(1, 1, 2).Close().Point(2, 2, 3).Point(3, 3, 4).BuildMultiLineString()
+			},
+			Expected: &geometry.MultiLineString{
+				Type: geometry.XYM,
+				Lines: []*geometry.LineString{
+					{Type: geometry.XYM, Points: []*geometry.Point{{X: 0, Y: 0, M: 1, Type: geometry.XYM}, {X: 1, Y: 1, M: 2, Type: geometry.XYM}}},
+					{Type: geometry.XYM, Points: []*geometry.Point{{X: 2, Y: 2, M: 3, Type: geometry.XYM}, {X: 3, Y: 3, M: 4, Type: geometry.XYM}}},
+				},
+			},
+		},
+		{
+			Name: "Builder wrong coordinate count",
+			Build: func() (geometry.Geometry, error) {
+				return builder.New(geometry.XYZ).Point(0, 0).Point(1, 1, 1).BuildLineString()
+			},
+			Error: builder.ErrInvalidCoordinates,
+		},
+		{
+			Name: "Builder circular string with even points",
+			Build: func() (geometry.Geometry, error) {
+				return builder.New(geometry.XY).Point(0, 0).Point(1, 1).BuildCircularString()
+			},
+			Error: builder.ErrTooFewPoints,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom, err := tc.Build()
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			if diff := cmp.Diff(geom, tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
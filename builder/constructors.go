@@ -0,0 +1,246 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+var (
+	ErrInvalidCoordinates      = errors.New("invalid coordinates")
+	ErrCoordinateTypeMismatch  = errors.New("coordinate type mismatch")
+	ErrTooFewPoints            = errors.New("too few points")
+	ErrRingNotClosed           = errors.New("ring is not closed")
+	ErrInvalidEnvelope         = errors.New("invalid envelope")
+	ErrMixedGeometryTypes      = errors.New("mixed geometry types")
+	ErrNoGeometries            = errors.New("no geometries")
+	ErrUnsupportedGeometryType = errors.New("unsupported geometry type")
+	ErrSRIDMismatch            = errors.New("srid mismatch")
+)
+
+// Minimal counts of points
+const (
+	minLinePoints           = 2
+	minCircularStringPoints = 3
+	minRingPoints           = 4
+)
+
+// MakePoint returns point with XY, XYZ or XYZM coordinates depending on their count, like ST_MakePoint
+func MakePoint(coords ...float64) (*geometry.Point, error) {
+	switch len(coords) {
+	case int(geometry.NumXY):
+		return newPoint(geometry.XY, coords)
+	case int(geometry.NumXYZ):
+		return newPoint(geometry.XYZ, coords)
+	case int(geometry.NumXYZM):
+		return newPoint(geometry.XYZM, coords)
+	default:
+		return nil, fmt.Errorf("%w: %d coordinates", ErrInvalidCoordinates, len(coords))
+	}
+}
+
+// MakePointM returns point with XYM coordinates, like ST_MakePointM
+func MakePointM(x, y, m float64) (*geometry.Point, error) {
+	return newPoint(geometry.XYM, []float64{x, y, m})
+}
+
+// MakeLine returns line string of copies of the points, like ST_MakeLine
+func MakeLine(points ...*geometry.Point) (*geometry.LineString, error) {
+	ct, err := pointsCoordType(points)
+	if err != nil {
+		return nil, err
+	}
+
+	line := &geometry.LineString{Type: ct, Points: clonePoints(points)}
+	if err := validateLine(line.Points); err != nil {
+		return nil, err
+	}
+	return line, nil
+}
+
+// MakePolygon returns polygon with copies of the shell and the holes as rings, like ST_MakePolygon
+func MakePolygon(shell *geometry.LineString, holes ...*geometry.LineString) (*geometry.Polygon, error) {
+	lines := append([]*geometry.LineString{shell}, holes...)
+	for i, line := range lines {
+		if line == nil {
+			return nil, fmt.Errorf("%w: ring %d is nil", ErrNoGeometries, i)
+		}
+	}
+
+	polygon := &geometry.Polygon{Type: shell.Type, Rings: make([]*geometry.LinearRing, 0, len(lines))}
+	for i, line := range lines {
+		if line.Type != polygon.Type {
+			return nil, fmt.Errorf("%w: ring %d is %s, expected %s", ErrCoordinateTypeMismatch, i, line.Type, polygon.Type)
+		}
+
+		ring := (*geometry.LinearRing)(line.Clone())
+		if err := validateRing(ring); err != nil {
+			return nil, fmt.Errorf("ring %d: %w", i, err)
+		}
+		polygon.Rings = append(polygon.Rings, ring)
+	}
+	return polygon, nil
+}
+
+// MakeEnvelope returns rectangular polygon, like ST_MakeEnvelope
+func MakeEnvelope(minX, minY, maxX, maxY float64) (*geometry.Polygon, error) {
+	if minX > maxX || minY > maxY {
+		return nil, fmt.Errorf("%w: (%v %v, %v %v)", ErrInvalidEnvelope, minX, minY, maxX, maxY)
+	}
+
+	return New(geometry.XY).
+		Point(minX, minY).Point(minX, maxY).Point(maxX, maxY).Point(maxX, minY).Point(minX, minY).
+		BuildPolygon()
+}
+
+// Collect returns multi geometry of copies of the geometries, like ST_Collect.
+//
+// All geometries must be either points, line strings or polygons of the same coordinate type.
+// Referenced geometries must have the same srid, the result is referenced to it.
+func Collect(geoms ...geometry.Geometry) (geometry.Geometry, error) {
+	if len(geoms) == 0 {
+		return nil, ErrNoGeometries
+	}
+
+	srid, referenced := sridOf(geoms[0]), false
+	unwrapped := make([]geometry.Geometry, 0, len(geoms))
+	for i, g := range geoms {
+		if s := sridOf(g); s != srid {
+			return nil, fmt.Errorf("%w: %d and %d", ErrSRIDMismatch, srid, s)
+		}
+		if r, ok := g.(*geometry.Referenced); ok && r != nil {
+			g, referenced = r.Geometry, true
+		}
+		if g == nil {
+			return nil, fmt.Errorf("%w: geometry %d is nil", ErrNoGeometries, i)
+		}
+		unwrapped = append(unwrapped, g)
+	}
+
+	collection, err := collect(unwrapped)
+	if err != nil || !referenced {
+		return collection, err
+	}
+	return geometry.WithSRID(collection, srid), nil
+}
+
+// sridOf returns srid of geometry, it is 0 for not referenced geometries
+func sridOf(g geometry.Geometry) int {
+	if s, ok := g.(geometry.SRIDer); ok {
+		return s.GetSRID()
+	}
+	return 0
+}
+
+// collect returns multi geometry of copies of not referenced geometries
+func collect(geoms []geometry.Geometry) (geometry.Geometry, error) {
+	gt, ct := geoms[0].GetGeometryType(), geoms[0].CoordType()
+	for _, g := range geoms {
+		if g.GetGeometryType() != gt {
+			return nil, fmt.Errorf("%w: %s and %s", ErrMixedGeometryTypes, gt, g.GetGeometryType())
+		}
+		if g.CoordType() != ct {
+			return nil, fmt.Errorf("%w: %s and %s", ErrCoordinateTypeMismatch, ct, g.CoordType())
+		}
+	}
+
+	switch gt {
+	case geometry.PointGT:
+		multiPoint := &geometry.MultiPoint{Type: ct}
+		for _, g := range geoms {
+			multiPoint.Points = append(multiPoint.Points, g.(*geometry.Point).Clone())
+		}
+		return multiPoint, nil
+
+	case geometry.LineStringGT:
+		multiLineString := &geometry.MultiLineString{Type: ct}
+		for _, g := range geoms {
+			multiLineString.Lines = append(multiLineString.Lines, g.(*geometry.LineString).Clone())
+		}
+		return multiLineString, nil
+
+	case geometry.PolygonGT:
+		multiPolygon := &geometry.MultiPolygon{Type: ct}
+		for _, g := range geoms {
+			multiPolygon.Polygons = append(multiPolygon.Polygons, g.(*geometry.Polygon).Clone())
+		}
+		return multiPolygon, nil
+
+	default:
+		return nil, fmt.Errorf("%w: can't collect %s", ErrUnsupportedGeometryType, gt)
+	}
+}
+
+// newPoint returns point with coordinates ordered as in wkt of the specified coordinate type
+func newPoint(ct geometry.CoordinateType, coords []float64) (*geometry.Point, error) {
+	if len(coords) != int(ct.NumCoordinates()) {
+		return nil, fmt.Errorf("%w: %d coordinates for %s", ErrInvalidCoordinates, len(coords), ct)
+	}
+
+	for _, c := range coords {
+		if math.IsNaN(c) || math.IsInf(c, 0) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCoordinates, coords)
+		}
+	}
+
+	point := &geometry.Point{Type: ct, X: coords[0], Y: coords[1]}
+	switch ct {
+	case geometry.XYZ:
+		point.Z = coords[2]
+	case geometry.XYM:
+		point.M = coords[2]
+	case geometry.XYZM:
+		point.Z, point.M = coords[2], coords[3]
+	}
+	return point, nil
+}
+
+func pointsCoordType(points []*geometry.Point) (geometry.CoordinateType, error) {
+	if len(points) == 0 {
+		return geometry.Undefined, fmt.Errorf("%w: no points", ErrTooFewPoints)
+	}
+
+	ct := points[0].Type
+	for _, point := range points {
+		if point.Type != ct {
+			return geometry.Undefined, fmt.Errorf("%w: %s and %s", ErrCoordinateTypeMismatch, ct, point.Type)
+		}
+	}
+	return ct, nil
+}
+
+func clonePoints(points []*geometry.Point) []*geometry.Point {
+	clone := make([]*geometry.Point, 0, len(points))
+	for _, point := range points {
+		clone = append(clone, point.Clone())
+	}
+	return clone
+}
+
+func validateLine(points []*geometry.Point) error {
+	if len(points) < minLinePoints {
+		return fmt.Errorf("%w: line string has %d points", ErrTooFewPoints, len(points))
+	}
+	return nil
+}
+
+func validateCircularString(points []*geometry.Point) error {
+	if len(points) < minCircularStringPoints || len(points)%2 == 0 {
+		return fmt.Errorf("%w: circular string has %d points, expected odd number not less than %d",
+			ErrTooFewPoints, len(points), minCircularStringPoints)
+	}
+	return nil
+}
+
+func validateRing(ring *geometry.LinearRing) error {
+	if len(ring.Points) < minRingPoints {
+		return fmt.Errorf("%w: ring has %d points", ErrTooFewPoints, len(ring.Points))
+	}
+
+	if !ring.IsClosed() {
+		return ErrRingNotClosed
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Package feature implements geometries with attributes shared by formats such as GeoJSON
+package feature
+
+import "github.com/IvanZagoskin/wkt/geometry"
+
+// Feature is a geometry with identifier and properties
+type Feature struct {
+	// ID is an optional identifier, usually a string or a number
+	ID       interface{}
+	Geometry geometry.Geometry
+	// Properties are attributes of the feature
+	Properties map[string]interface{}
+	// SRID is an optional spatial reference system identifier of the geometry, zero if it is unknown.
+	// It takes precedence over SRID of a geometry.Referenced geometry, see GetSRID.
+	SRID int
+}
+
+// New returns feature of geometry with empty properties
+func New(g geometry.Geometry) *Feature {
+	return &Feature{Geometry: g, Properties: map[string]interface{}{}}
+}
+
+// GetSRID returns spatial reference system identifier of the geometry:
+// SRID of the feature if it is set, otherwise SRID of the geometry if it has one
+func (f *Feature) GetSRID() int {
+	if f.SRID != 0 {
+		return f.SRID
+	}
+	if s, ok := f.Geometry.(geometry.SRIDer); ok {
+		return s.GetSRID()
+	}
+	return 0
+}
+
+// Referenced returns geometry of the feature referenced to its SRID, see geometry.WithSRID
+func (f *Feature) Referenced() geometry.Geometry {
+	srid := f.GetSRID()
+	if r, ok := f.Geometry.(*geometry.Referenced); ok {
+		if r == nil || r.SRID == srid {
+			return r
+		}
+		return geometry.WithSRID(r.Geometry, srid)
+	}
+	if srid == 0 || f.Geometry == nil {
+		return f.Geometry
+	}
+	return geometry.WithSRID(f.Geometry, srid)
+}
+
+// Bounds returns envelope of the geometry, empty envelope is returned if there is no geometry
+func (f *Feature) Bounds() geometry.Envelope {
+	if f.Geometry == nil {
+		return geometry.EmptyEnvelope()
+	}
+	return f.Geometry.Bounds()
+}
+
+// FeatureCollection is a collection of features
+type FeatureCollection struct {
+	Features []*Feature
+}
+
+// NewFeatureCollection returns collection of features
+func NewFeatureCollection(features ...*Feature) *FeatureCollection {
+	return &FeatureCollection{Features: features}
+}
+
+// Append adds features to the collection
+func (c *FeatureCollection) Append(features ...*Feature) {
+	c.Features = append(c.Features, features...)
+}
+
+// Len returns count of features
+func (c *FeatureCollection) Len() int {
+	return len(c.Features)
+}
+
+// Bounds returns envelope of geometries of all features
+func (c *FeatureCollection) Bounds() geometry.Envelope {
+	env := geometry.EmptyEnvelope()
+	for _, f := range c.Features {
+		env = env.Union(f.Bounds())
+	}
+	return env
+}
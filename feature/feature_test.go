@@ -0,0 +1,63 @@
+package feature_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/feature"
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/writer"
+)
+
+func TestFeatureCollection_Bounds(t *testing.T) {
+	c := feature.NewFeatureCollection(
+		feature.New(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}),
+		&feature.Feature{ID: "no geometry"},
+	)
+	c.Append(feature.New(&geometry.LineString{Type: geometry.XY, Points: []*geometry.Point{
+		{X: -1, Y: 5, Type: geometry.XY},
+		{X: 0, Y: 0, Type: geometry.XY},
+	}}))
+
+	if c.Len() != 3 {
+		t.Fatalf("\ngot: %d\nexpected: %d\n", c.Len(), 3)
+	}
+
+	expected := geometry.EmptyEnvelope()
+	expected.MinX, expected.MinY, expected.MaxX, expected.MaxY = -1, 0, 1, 5
+	if diff := cmp.Diff(c.Bounds(), expected); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
+
+func TestFeature_Referenced(t *testing.T) {
+	f := feature.New(&geometry.Point{X: 1, Y: 2, Type: geometry.XY})
+	f.SRID = 4326
+
+	ewkt, err := writer.MarshalEWKT(f.Referenced(), 0)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(string(ewkt), "SRID=4326;POINT(1 2)"); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
+
+func TestFeature_GetSRID(t *testing.T) {
+	point := &geometry.Point{X: 1, Y: 2, Type: geometry.XY}
+
+	f := feature.New(geometry.WithSRID(point, 3857))
+	if f.GetSRID() != 3857 {
+		t.Fatalf("\ngot: %d\nexpected: %d\n", f.GetSRID(), 3857)
+	}
+
+	f.SRID = 4326
+	if f.GetSRID() != 4326 {
+		t.Fatalf("\ngot: %d\nexpected: %d\n", f.GetSRID(), 4326)
+	}
+	if diff := cmp.Diff(f.Referenced(), geometry.Geometry(geometry.WithSRID(point, 4326))); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
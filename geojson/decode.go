@@ -0,0 +1,236 @@
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// object is GeoJSON geometry object
+type object struct {
+	Type        string          `json:"type"`
+	BBox        []float64       `json:"bbox"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// Unmarshal returns geometry decoded from GeoJSON geometry object.
+// Positions must have the same number of coordinates, either two or three.
+func Unmarshal(data []byte) (geometry.Geometry, error) {
+	var obj object
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("unmarshal object: %w", err)
+	}
+
+	d := &decoder{}
+	g, err := d.decode(&obj)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(obj.BBox) {
+	case 0:
+	case 4, 6:
+		if (len(obj.BBox) == 6) != (d.ct == geometry.XYZ) && !g.IsEmpty() {
+			return nil, fmt.Errorf("%w: %d values of %s", ErrInvalidBBox, len(obj.BBox), d.ct)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %d values", ErrInvalidBBox, len(obj.BBox))
+	}
+	return g, nil
+}
+
+// decoder keeps coordinate type of the first position to check the rest of positions
+type decoder struct {
+	ct geometry.CoordinateType
+}
+
+func (d *decoder) decode(obj *object) (geometry.Geometry, error) {
+	switch obj.Type {
+	case TypePoint:
+		var position []float64
+		if err := d.unmarshal(obj.Coordinates, &position); err != nil {
+			return nil, err
+		}
+		if len(position) == 0 {
+			return &geometry.Point{Type: geometry.Empty}, nil
+		}
+		return d.point(position)
+
+	case TypeMultiPoint:
+		var positions [][]float64
+		if err := d.unmarshal(obj.Coordinates, &positions); err != nil {
+			return nil, err
+		}
+		if len(positions) == 0 {
+			return &geometry.MultiPoint{Type: geometry.Empty}, nil
+		}
+		points, err := d.points(positions)
+		if err != nil {
+			return nil, err
+		}
+		return &geometry.MultiPoint{Type: d.ct, Points: points}, nil
+
+	case TypeLineString:
+		var positions [][]float64
+		if err := d.unmarshal(obj.Coordinates, &positions); err != nil {
+			return nil, err
+		}
+		if len(positions) == 0 {
+			return &geometry.LineString{Type: geometry.Empty}, nil
+		}
+		points, err := d.points(positions)
+		if err != nil {
+			return nil, err
+		}
+		return &geometry.LineString{Type: d.ct, Points: points}, nil
+
+	case TypeMultiLineString:
+		var lines [][][]float64
+		if err := d.unmarshal(obj.Coordinates, &lines); err != nil {
+			return nil, err
+		}
+		if len(lines) == 0 {
+			return &geometry.MultiLineString{Type: geometry.Empty}, nil
+		}
+
+		multiLineString := &geometry.MultiLineString{Lines: make([]*geometry.LineString, 0, len(lines))}
+		for _, positions := range lines {
+			points, err := d.points(positions)
+			if err != nil {
+				return nil, err
+			}
+			multiLineString.Lines = append(multiLineString.Lines, &geometry.LineString{Type: d.elementType(points), Points: points})
+		}
+		if d.ct == geometry.Undefined {
+			return &geometry.MultiLineString{Type: geometry.Empty}, nil
+		}
+		multiLineString.Type = d.ct
+		return multiLineString, nil
+
+	case TypePolygon:
+		var rings [][][]float64
+		if err := d.unmarshal(obj.Coordinates, &rings); err != nil {
+			return nil, err
+		}
+		if len(rings) == 0 {
+			return &geometry.Polygon{Type: geometry.Empty}, nil
+		}
+		polygon, err := d.polygon(rings)
+		if err != nil {
+			return nil, err
+		}
+		if polygon.IsEmpty() {
+			return &geometry.Polygon{Type: geometry.Empty}, nil
+		}
+		return polygon, nil
+
+	case TypeMultiPolygon:
+		var polygons [][][][]float64
+		if err := d.unmarshal(obj.Coordinates, &polygons); err != nil {
+			return nil, err
+		}
+		if len(polygons) == 0 {
+			return &geometry.MultiPolygon{Type: geometry.Empty}, nil
+		}
+
+		multiPolygon := &geometry.MultiPolygon{Polygons: make([]*geometry.Polygon, 0, len(polygons))}
+		for _, rings := range polygons {
+			polygon, err := d.polygon(rings)
+			if err != nil {
+				return nil, err
+			}
+			multiPolygon.Polygons = append(multiPolygon.Polygons, polygon)
+		}
+		if d.ct == geometry.Undefined {
+			return &geometry.MultiPolygon{Type: geometry.Empty}, nil
+		}
+		multiPolygon.Type = d.ct
+		return multiPolygon, nil
+
+	case TypeGeometryCollection:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedGeometry, obj.Type)
+
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownType, obj.Type)
+	}
+}
+
+func (d *decoder) unmarshal(coordinates json.RawMessage, v interface{}) error {
+	if len(coordinates) == 0 || string(coordinates) == null {
+		return fmt.Errorf("%w: coordinates are absent", ErrInvalidPosition)
+	}
+	if err := json.Unmarshal(coordinates, v); err != nil {
+		return fmt.Errorf("unmarshal coordinates: %w", err)
+	}
+	return nil
+}
+
+func (d *decoder) polygon(rings [][][]float64) (*geometry.Polygon, error) {
+	polygon := &geometry.Polygon{Rings: make([]*geometry.LinearRing, 0, len(rings))}
+	for _, positions := range rings {
+		points, err := d.points(positions)
+		if err != nil {
+			return nil, err
+		}
+		polygon.Rings = append(polygon.Rings, &geometry.LinearRing{Type: d.elementType(points), Points: points})
+	}
+
+	polygon.Type = geometry.Empty
+	for _, ring := range polygon.Rings {
+		if !ring.IsEmpty() {
+			polygon.Type = d.ct
+		}
+	}
+	return polygon, nil
+}
+
+// elementType returns coordinate type of element consisting of points
+func (d *decoder) elementType(points []*geometry.Point) geometry.CoordinateType {
+	if len(points) == 0 {
+		return geometry.Empty
+	}
+	return d.ct
+}
+
+func (d *decoder) points(positions [][]float64) ([]*geometry.Point, error) {
+	if len(positions) == 0 {
+		return nil, nil
+	}
+
+	points := make([]*geometry.Point, 0, len(positions))
+	for _, position := range positions {
+		point, err := d.point(position)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+// point returns point of position checking that it has the same coordinate type as the previous positions
+func (d *decoder) point(position []float64) (*geometry.Point, error) {
+	var ct geometry.CoordinateType
+	switch len(position) {
+	case 2:
+		ct = geometry.XY
+	case 3:
+		ct = geometry.XYZ
+	default:
+		return nil, fmt.Errorf("%w: %d coordinates", ErrInvalidPosition, len(position))
+	}
+
+	if d.ct == geometry.Undefined {
+		d.ct = ct
+	}
+	if ct != d.ct {
+		return nil, fmt.Errorf("%w: %s position of %s geometry", ErrUnexpectedCoordinateType, ct, d.ct)
+	}
+
+	point := &geometry.Point{Type: ct, X: position[0], Y: position[1]}
+	if ct == geometry.XYZ {
+		point.Z = position[2]
+	}
+	return point, nil
+}
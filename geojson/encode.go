@@ -0,0 +1,198 @@
+package geojson
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Writer implements writing GeoJSON
+type Writer struct {
+	bbox bool
+}
+
+// Option configures Writer
+type Option func(w *Writer)
+
+// WithBBox makes Writer write bbox member of geometries
+func WithBBox() Option {
+	return func(w *Writer) {
+		w.bbox = true
+	}
+}
+
+// New returns Writer
+func New(opts ...Option) *Writer {
+	w := &Writer{}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Marshal returns GeoJSON of geometry
+func Marshal(g geometry.Geometry) ([]byte, error) {
+	return New().Marshal(g)
+}
+
+// Marshal returns GeoJSON of geometry
+func (w *Writer) Marshal(g geometry.Geometry) ([]byte, error) {
+	return w.AppendGeoJSON(nil, g)
+}
+
+// AppendGeoJSON appends GeoJSON of geometry to dst and returns the extended buffer.
+// Only XY and XYZ coordinates are supported, GeoJSON has no M coordinate.
+func (w *Writer) AppendGeoJSON(dst []byte, g geometry.Geometry) ([]byte, error) {
+	if r, ok := g.(*geometry.Referenced); ok {
+		if r == nil {
+			return dst, fmt.Errorf("%w: nil %T", ErrUnsupportedGeometry, r)
+		}
+		g = r.Geometry
+	}
+
+	switch g.(type) {
+	case *geometry.Point, *geometry.MultiPoint, *geometry.LineString,
+		*geometry.MultiLineString, *geometry.Polygon, *geometry.MultiPolygon:
+	default:
+		return dst, fmt.Errorf("%w: %T", ErrUnsupportedGeometry, g)
+	}
+	if err := validateParts(g); err != nil {
+		return dst, err
+	}
+	name, _ := typeName(g.GetGeometryType())
+
+	ct := g.CoordType()
+	switch ct {
+	case geometry.XY, geometry.XYZ, geometry.Empty:
+	default:
+		return dst, fmt.Errorf("%w: %s", ErrUnexpectedCoordinateType, ct)
+	}
+
+	dst = append(dst, `{"type":"`...)
+	dst = append(dst, name...)
+	dst = append(dst, '"')
+
+	if w.bbox && !g.IsEmpty() {
+		env := g.Bounds()
+		bbox := []float64{env.MinX, env.MinY, env.MaxX, env.MaxY}
+		if ct.HasZ() {
+			bbox = []float64{env.MinX, env.MinY, env.MinZ, env.MaxX, env.MaxY, env.MaxZ}
+		}
+
+		dst = append(dst, `,"bbox":`...)
+		var err error
+		if dst, err = appendNumbers(dst, bbox); err != nil {
+			return dst, err
+		}
+	}
+
+	dst = append(dst, `,"coordinates":`...)
+	var err error
+	switch geom := g.(type) {
+	case *geometry.Point:
+		if geom.IsEmpty() {
+			dst = append(dst, "[]"...)
+			break
+		}
+		dst, err = appendPosition(dst, geom, ct)
+	case *geometry.MultiPoint:
+		dst, err = appendPositions(dst, geom.Points, ct)
+	case *geometry.LineString:
+		dst, err = appendPositions(dst, geom.Points, ct)
+	case *geometry.MultiLineString:
+		dst, err = appendList(dst, len(geom.Lines), func(dst []byte, i int) ([]byte, error) {
+			return appendPositions(dst, geom.Lines[i].Points, ct)
+		})
+	case *geometry.Polygon:
+		dst, err = appendRings(dst, geom.Rings, ct)
+	case *geometry.MultiPolygon:
+		dst, err = appendList(dst, len(geom.Polygons), func(dst []byte, i int) ([]byte, error) {
+			return appendRings(dst, geom.Polygons[i].Rings, ct)
+		})
+	}
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, '}'), nil
+}
+
+// validateParts rejects nil geometry and nil parts, they have no GeoJSON representation
+func validateParts(g geometry.Geometry) error {
+	return geometry.Walk(g, func(path []int, part geometry.Geometry) error {
+		if geometry.IsNil(part) {
+			return fmt.Errorf("%w: nil %T at %v", ErrUnsupportedGeometry, part, path)
+		}
+		return nil
+	})
+}
+
+func appendRings(dst []byte, rings []*geometry.LinearRing, ct geometry.CoordinateType) ([]byte, error) {
+	return appendList(dst, len(rings), func(dst []byte, i int) ([]byte, error) {
+		return appendPositions(dst, rings[i].Points, ct)
+	})
+}
+
+func appendPositions(dst []byte, points []*geometry.Point, ct geometry.CoordinateType) ([]byte, error) {
+	return appendList(dst, len(points), func(dst []byte, i int) ([]byte, error) {
+		return appendPosition(dst, points[i], ct)
+	})
+}
+
+// appendList appends json array of n items
+func appendList(dst []byte, n int, appendItem func(dst []byte, i int) ([]byte, error)) ([]byte, error) {
+	dst = append(dst, '[')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		var err error
+		if dst, err = appendItem(dst, i); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
+
+// appendPosition appends coordinates of point which must have the coordinate type of the geometry
+func appendPosition(dst []byte, p *geometry.Point, ct geometry.CoordinateType) ([]byte, error) {
+	if p.Type != ct {
+		return dst, fmt.Errorf("%w: %s position of %s geometry", ErrUnexpectedCoordinateType, p.Type, ct)
+	}
+
+	switch p.Type {
+	case geometry.XY:
+		return appendNumbers(dst, []float64{p.X, p.Y})
+	case geometry.XYZ:
+		return appendNumbers(dst, []float64{p.X, p.Y, p.Z})
+	default:
+		return dst, fmt.Errorf("%w: %s", ErrUnexpectedCoordinateType, p.Type)
+	}
+}
+
+// appendNumbers appends json array of numbers formatted as by encoding/json
+func appendNumbers(dst []byte, numbers []float64) ([]byte, error) {
+	dst = append(dst, '[')
+	for i, f := range numbers {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return dst, fmt.Errorf("%w: %v", ErrInvalidPosition, f)
+		}
+
+		format := byte('f')
+		if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+			format = 'e'
+		}
+		dst = strconv.AppendFloat(dst, f, format, -1, 64)
+		if n := len(dst); format == 'e' && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			// clean up e-07 to e-7
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return append(dst, ']'), nil
+}
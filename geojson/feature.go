@@ -0,0 +1,162 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/IvanZagoskin/wkt/feature"
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// featureObject is GeoJSON feature object
+type featureObject struct {
+	Type       string                 `json:"type"`
+	ID         interface{}            `json:"id,omitempty"`
+	Geometry   json.RawMessage        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// collectionObject is GeoJSON feature collection object
+type collectionObject struct {
+	Type     string            `json:"type"`
+	Features []json.RawMessage `json:"features"`
+}
+
+// null is json null of absent geometry
+const null = "null"
+
+// MarshalFeature returns GeoJSON of feature
+func MarshalFeature(f *feature.Feature) ([]byte, error) {
+	return New().MarshalFeature(f)
+}
+
+// MarshalFeature returns GeoJSON of feature. Feature without geometry has null geometry.
+// ID must be a string or a number and SRID of the feature must be zero or 4326.
+func (w *Writer) MarshalFeature(f *feature.Feature) ([]byte, error) {
+	obj, err := w.featureObject(f)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// MarshalFeatureCollection returns GeoJSON of feature collection
+func MarshalFeatureCollection(c *feature.FeatureCollection) ([]byte, error) {
+	return New().MarshalFeatureCollection(c)
+}
+
+// MarshalFeatureCollection returns GeoJSON of feature collection
+func (w *Writer) MarshalFeatureCollection(c *feature.FeatureCollection) ([]byte, error) {
+	obj := collectionObject{Type: TypeFeatureCollection, Features: make([]json.RawMessage, 0, len(c.Features))}
+	for i, f := range c.Features {
+		data, err := w.MarshalFeature(f)
+		if err != nil {
+			return nil, fmt.Errorf("marshal feature %d: %w", i, err)
+		}
+		obj.Features = append(obj.Features, data)
+	}
+	return json.Marshal(obj)
+}
+
+func (w *Writer) featureObject(f *feature.Feature) (*featureObject, error) {
+	if err := validateID(f.ID); err != nil {
+		return nil, err
+	}
+	if err := validateSRID(f); err != nil {
+		return nil, err
+	}
+
+	obj := &featureObject{Type: TypeFeature, ID: f.ID, Geometry: json.RawMessage(null), Properties: f.Properties}
+	if f.Geometry != nil {
+		data, err := w.Marshal(f.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("marshal geometry: %w", err)
+		}
+		obj.Geometry = data
+	}
+	return obj, nil
+}
+
+// validateID checks that id is absent, a string or a number as required by RFC 7946
+func validateID(id interface{}) error {
+	switch id.(type) {
+	case nil, string, json.Number, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return nil
+	default:
+		return fmt.Errorf("%w: %T", ErrInvalidID, id)
+	}
+}
+
+// validateSRID checks that the feature and its geometry are WGS 84 or have no SRID
+func validateSRID(f *feature.Feature) error {
+	if s, ok := f.Geometry.(geometry.SRIDer); ok && f.SRID != 0 && s.GetSRID() != 0 && s.GetSRID() != f.SRID {
+		return fmt.Errorf("%w: feature srid %d, geometry srid %d", ErrUnsupportedSRID, f.SRID, s.GetSRID())
+	}
+	if srid := f.GetSRID(); srid != 0 && srid != sridWGS84 {
+		return fmt.Errorf("%w: %d", ErrUnsupportedSRID, srid)
+	}
+	return nil
+}
+
+// decode decodes single JSON value of data into v, numbers are decoded as json.Number
+func decode(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		// json.Unmarshal reports the syntax error of data following the value
+		return json.Unmarshal(data, new(json.RawMessage))
+	}
+	return nil
+}
+
+// UnmarshalFeature returns feature decoded from GeoJSON feature object.
+// SRID of feature is 4326 as coordinates of GeoJSON are WGS 84.
+// Numbers of ID and properties are decoded as json.Number.
+func UnmarshalFeature(data []byte) (*feature.Feature, error) {
+	var obj featureObject
+	if err := decode(data, &obj); err != nil {
+		return nil, fmt.Errorf("unmarshal feature: %w", err)
+	}
+	if obj.Type != TypeFeature {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownType, obj.Type)
+	}
+	if err := validateID(obj.ID); err != nil {
+		return nil, err
+	}
+
+	f := &feature.Feature{ID: obj.ID, Properties: obj.Properties, SRID: sridWGS84}
+	if len(obj.Geometry) != 0 && string(obj.Geometry) != null {
+		g, err := Unmarshal(obj.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal geometry: %w", err)
+		}
+		f.Geometry = g
+	}
+	return f, nil
+}
+
+// UnmarshalFeatureCollection returns feature collection decoded from GeoJSON feature collection object
+func UnmarshalFeatureCollection(data []byte) (*feature.FeatureCollection, error) {
+	var obj collectionObject
+	if err := decode(data, &obj); err != nil {
+		return nil, fmt.Errorf("unmarshal feature collection: %w", err)
+	}
+	if obj.Type != TypeFeatureCollection {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownType, obj.Type)
+	}
+
+	c := &feature.FeatureCollection{Features: make([]*feature.Feature, 0, len(obj.Features))}
+	for i, data := range obj.Features {
+		f, err := UnmarshalFeature(data)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal feature %d: %w", i, err)
+		}
+		c.Features = append(c.Features, f)
+	}
+	return c, nil
+}
@@ -0,0 +1,152 @@
+package geojson_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/feature"
+	"github.com/IvanZagoskin/wkt/geojson"
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func TestFeatureCollection(t *testing.T) {
+	point := feature.New(parse(t, "POINT (1 2)"))
+	point.ID = "a"
+	point.Properties["name"] = "first"
+	point.Properties["height"] = json.Number("12.5")
+
+	c := feature.NewFeatureCollection(point, &feature.Feature{ID: json.Number("2")})
+
+	data, err := geojson.MarshalFeatureCollection(c)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	expected := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","id":"a","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"height":12.5,"name":"first"}},` +
+		`{"type":"Feature","id":2,"geometry":null,"properties":null}]}`
+	if diff := cmp.Diff(string(data), expected); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	decoded, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	point.SRID = 4326
+	c.Features[1].SRID = 4326
+	if diff := cmp.Diff(decoded, c); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
+
+func TestUnmarshalFeature_Error(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		GeoJSON string
+		Error   error
+	}{
+		{
+			Name:    "Not a feature",
+			GeoJSON: `{"type":"Point","coordinates":[1,2]}`,
+			Error:   geojson.ErrUnknownType,
+		},
+		{
+			Name:    "Invalid id",
+			GeoJSON: `{"type":"Feature","id":true,"geometry":null,"properties":{}}`,
+			Error:   geojson.ErrInvalidID,
+		},
+		{
+			Name:    "Invalid geometry",
+			GeoJSON: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1]},"properties":{}}`,
+			Error:   geojson.ErrInvalidPosition,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := geojson.UnmarshalFeature([]byte(tc.GeoJSON)); !errors.Is(err, tc.Error) {
+				t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+			}
+		})
+	}
+
+	if _, err := geojson.UnmarshalFeatureCollection([]byte(`{"type":"Feature"}`)); !errors.Is(err, geojson.ErrUnknownType) {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, geojson.ErrUnknownType)
+	}
+}
+
+func TestUnmarshalFeature_Numbers(t *testing.T) {
+	f, err := geojson.UnmarshalFeature([]byte(`{"type":"Feature","id":12345678901234567890,"geometry":null,"properties":{"n":1}}`))
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	expected := &feature.Feature{
+		ID:         json.Number("12345678901234567890"),
+		Properties: map[string]interface{}{"n": json.Number("1")},
+		SRID:       4326,
+	}
+	if diff := cmp.Diff(f, expected); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if _, err := geojson.UnmarshalFeature([]byte(`{"type":"Feature","geometry":null,"properties":{}} {}`)); err == nil {
+		t.Fatal("\nexpected error for trailing data\n")
+	}
+}
+
+func TestMarshalFeature_Error(t *testing.T) {
+	point := geometry.Point{X: 1, Y: 2, Type: geometry.XY}
+
+	testCases := []struct {
+		Name    string
+		Feature *feature.Feature
+		Error   error
+	}{
+		{
+			Name:    "Invalid id",
+			Feature: &feature.Feature{ID: []int{1}, Geometry: &point},
+			Error:   geojson.ErrInvalidID,
+		},
+		{
+			Name:    "Unsupported feature srid",
+			Feature: &feature.Feature{Geometry: &point, SRID: 3857},
+			Error:   geojson.ErrUnsupportedSRID,
+		},
+		{
+			Name:    "Unsupported geometry srid",
+			Feature: &feature.Feature{Geometry: geometry.WithSRID(&point, 3857)},
+			Error:   geojson.ErrUnsupportedSRID,
+		},
+		{
+			Name:    "Mismatched srid",
+			Feature: &feature.Feature{Geometry: geometry.WithSRID(&point, 3857), SRID: 4326},
+			Error:   geojson.ErrUnsupportedSRID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := geojson.MarshalFeature(tc.Feature); !errors.Is(err, tc.Error) {
+				t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+			}
+		})
+	}
+
+	f := &feature.Feature{ID: 7, Geometry: geometry.WithSRID(&point, 4326)}
+	data, err := geojson.MarshalFeature(f)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+	expected := `{"type":"Feature","id":7,"geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`
+	if diff := cmp.Diff(string(data), expected); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
@@ -0,0 +1,78 @@
+// Package geojson implements reading and writing of GeoJSON geometry objects as defined by RFC 7946
+package geojson
+
+import (
+	"errors"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+var (
+	ErrUnsupportedGeometry      = errors.New("unsupported geometry")
+	ErrUnexpectedCoordinateType = errors.New("unexpected coordinate type")
+	ErrUnknownType              = errors.New("unknown type")
+	ErrInvalidPosition          = errors.New("invalid position")
+	ErrInvalidBBox              = errors.New("invalid bbox")
+	ErrInvalidID                = errors.New("invalid id")
+	ErrUnsupportedSRID          = errors.New("unsupported srid")
+)
+
+// GeoJSON types of geometries
+const (
+	TypePoint              = "Point"
+	TypeMultiPoint         = "MultiPoint"
+	TypeLineString         = "LineString"
+	TypeMultiLineString    = "MultiLineString"
+	TypePolygon            = "Polygon"
+	TypeMultiPolygon       = "MultiPolygon"
+	TypeGeometryCollection = "GeometryCollection"
+)
+
+// GeoJSON types of features
+const (
+	TypeFeature           = "Feature"
+	TypeFeatureCollection = "FeatureCollection"
+)
+
+// sridWGS84 is SRID of coordinates of RFC 7946 GeoJSON
+const sridWGS84 = 4326
+
+// Geometry wraps geometry.Geometry to implement json.Marshaler and json.Unmarshaler
+type Geometry struct {
+	geometry.Geometry
+}
+
+// MarshalJSON returns GeoJSON of geometry
+func (g Geometry) MarshalJSON() ([]byte, error) {
+	return Marshal(g.Geometry)
+}
+
+// UnmarshalJSON decodes GeoJSON geometry
+func (g *Geometry) UnmarshalJSON(data []byte) error {
+	geom, err := Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	g.Geometry = geom
+	return nil
+}
+
+// typeName returns GeoJSON type of geometry type
+func typeName(gt geometry.Type) (string, bool) {
+	switch gt {
+	case geometry.PointGT:
+		return TypePoint, true
+	case geometry.MultiPointGT:
+		return TypeMultiPoint, true
+	case geometry.LineStringGT:
+		return TypeLineString, true
+	case geometry.MultiLineStringGT:
+		return TypeMultiLineString, true
+	case geometry.PolygonGT:
+		return TypePolygon, true
+	case geometry.MultiPolygonGT:
+		return TypeMultiPolygon, true
+	default:
+		return "", false
+	}
+}
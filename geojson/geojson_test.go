@@ -0,0 +1,278 @@
+package geojson_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geojson"
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/parser"
+)
+
+func parse(t *testing.T, wkt string) geometry.Geometry {
+	geom, err := parser.New().ParseWKT(bytes.NewReader([]byte(wkt)))
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+	return geom
+}
+
+func TestMarshal(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Wkt      string
+		Options  []geojson.Option
+		Expected string
+		Error    error
+	}{
+		{
+			Name:     "Point",
+			Wkt:      "POINT (30 -20.5)",
+			Expected: `{"type":"Point","coordinates":[30,-20.5]}`,
+		},
+		{
+			Name:     "Point Z",
+			Wkt:      "POINT Z (30 20 1e-7)",
+			Expected: `{"type":"Point","coordinates":[30,20,1e-7]}`,
+		},
+		{
+			Name:     "Empty point",
+			Wkt:      "POINT EMPTY",
+			Expected: `{"type":"Point","coordinates":[]}`,
+		},
+		{
+			Name:     "Multipoint",
+			Wkt:      "MULTIPOINT (10 40, 40 30)",
+			Expected: `{"type":"MultiPoint","coordinates":[[10,40],[40,30]]}`,
+		},
+		{
+			Name:     "Linestring",
+			Wkt:      "LINESTRING (30 10, 10 30, 40 40)",
+			Expected: `{"type":"LineString","coordinates":[[30,10],[10,30],[40,40]]}`,
+		},
+		{
+			Name:     "Multilinestring",
+			Wkt:      "MULTILINESTRING ((10 10, 20 20), (40 40, 30 30))",
+			Expected: `{"type":"MultiLineString","coordinates":[[[10,10],[20,20]],[[40,40],[30,30]]]}`,
+		},
+		{
+			Name:     "Polygon",
+			Wkt:      "POLYGON ((35 10, 45 45, 15 40, 35 10), (20 30, 35 35, 30 20, 20 30))",
+			Expected: `{"type":"Polygon","coordinates":[[[35,10],[45,45],[15,40],[35,10]],[[20,30],[35,35],[30,20],[20,30]]]}`,
+		},
+		{
+			Name:     "Multipolygon",
+			Wkt:      "MULTIPOLYGON (((40 40, 20 45, 45 30, 40 40)), ((20 35, 10 30, 10 10, 20 35)))",
+			Expected: `{"type":"MultiPolygon","coordinates":[[[[40,40],[20,45],[45,30],[40,40]]],[[[20,35],[10,30],[10,10],[20,35]]]]}`,
+		},
+		{
+			Name:     "Empty multipolygon",
+			Wkt:      "MULTIPOLYGON EMPTY",
+			Expected: `{"type":"MultiPolygon","coordinates":[]}`,
+		},
+		{
+			Name:     "BBox",
+			Wkt:      "LINESTRING (30 10, 10 30, 40 40)",
+			Options:  []geojson.Option{geojson.WithBBox()},
+			Expected: `{"type":"LineString","bbox":[10,10,40,40],"coordinates":[[30,10],[10,30],[40,40]]}`,
+		},
+		{
+			Name:     "BBox Z",
+			Wkt:      "POINT Z (1 2 3)",
+			Options:  []geojson.Option{geojson.WithBBox()},
+			Expected: `{"type":"Point","bbox":[1,2,3,1,2,3],"coordinates":[1,2,3]}`,
+		},
+		{
+			Name:  "M coordinates",
+			Wkt:   "POINT M (1 2 3)",
+			Error: geojson.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:  "Circularstring",
+			Wkt:   "CIRCULARSTRING (1 0, 0 1, -1 0)",
+			Error: geojson.ErrUnsupportedGeometry,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom := parse(t, tc.Wkt)
+
+			data, err := geojson.New(tc.Options...).Marshal(geom)
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(string(data), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			decoded, err := geojson.Unmarshal(data)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(decoded, geom); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshal_Error(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Geometry geometry.Geometry
+		Error    error
+	}{
+		{
+			Name:     "Nil referenced",
+			Geometry: (*geometry.Referenced)(nil),
+			Error:    geojson.ErrUnsupportedGeometry,
+		},
+		{
+			Name:     "Nil geometry",
+			Geometry: (*geometry.Polygon)(nil),
+			Error:    geojson.ErrUnsupportedGeometry,
+		},
+		{
+			Name:     "Nil point",
+			Geometry: &geometry.MultiPoint{Type: geometry.XY, Points: []*geometry.Point{nil}},
+			Error:    geojson.ErrUnsupportedGeometry,
+		},
+		{
+			Name: "Position of other coordinate type",
+			Geometry: &geometry.LineString{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {X: 3, Y: 4, Z: 5, Type: geometry.XYZ}},
+			},
+			Error: geojson.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name: "Ring of other coordinate type",
+			Geometry: &geometry.Polygon{
+				Type: geometry.XYZ,
+				Rings: []*geometry.LinearRing{{Type: geometry.XY, Points: []*geometry.Point{
+					{X: 0, Y: 0, Type: geometry.XY}, {X: 1, Y: 0, Type: geometry.XY}, {X: 0, Y: 0, Type: geometry.XY},
+				}}},
+			},
+			Error: geojson.ErrUnexpectedCoordinateType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := geojson.Marshal(tc.Geometry); !errors.Is(err, tc.Error) {
+				t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+			}
+		})
+	}
+}
+
+func TestUnmarshal_Error(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		GeoJSON string
+		Error   error
+	}{
+		{
+			Name:    "Z mismatch",
+			GeoJSON: `{"type":"LineString","coordinates":[[1,2],[3,4,5]]}`,
+			Error:   geojson.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:    "Z mismatch of parts",
+			GeoJSON: `{"type":"MultiPolygon","coordinates":[[[[1,2,3],[3,4,5],[1,2,3]]],[[[1,2],[3,4],[1,2]]]]}`,
+			Error:   geojson.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:    "M coordinates",
+			GeoJSON: `{"type":"Point","coordinates":[1,2,3,4]}`,
+			Error:   geojson.ErrInvalidPosition,
+		},
+		{
+			Name:    "Short position",
+			GeoJSON: `{"type":"MultiPoint","coordinates":[[1]]}`,
+			Error:   geojson.ErrInvalidPosition,
+		},
+		{
+			Name:    "Absent coordinates",
+			GeoJSON: `{"type":"Point"}`,
+			Error:   geojson.ErrInvalidPosition,
+		},
+		{
+			Name:    "Null coordinates",
+			GeoJSON: `{"type":"Polygon","coordinates":null}`,
+			Error:   geojson.ErrInvalidPosition,
+		},
+		{
+			Name:    "BBox mismatch",
+			GeoJSON: `{"type":"Point","bbox":[1,2,3,1,2,3],"coordinates":[1,2]}`,
+			Error:   geojson.ErrInvalidBBox,
+		},
+		{
+			Name:    "Invalid bbox",
+			GeoJSON: `{"type":"Point","bbox":[1,2,3],"coordinates":[1,2]}`,
+			Error:   geojson.ErrInvalidBBox,
+		},
+		{
+			Name:    "Geometry collection",
+			GeoJSON: `{"type":"GeometryCollection","geometries":[]}`,
+			Error:   geojson.ErrUnsupportedGeometry,
+		},
+		{
+			Name:    "Unknown type",
+			GeoJSON: `{"type":"Circle","coordinates":[1,2]}`,
+			Error:   geojson.ErrUnknownType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := geojson.Unmarshal([]byte(tc.GeoJSON)); !errors.Is(err, tc.Error) {
+				t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+			}
+		})
+	}
+}
+
+func TestGeometry_JSON(t *testing.T) {
+	type place struct {
+		Name     string           `json:"name"`
+		Location geojson.Geometry `json:"location"`
+	}
+
+	input := `{"name":"origin","location":{"type":"Point","coordinates":[0,0]}}`
+
+	var p place
+	if err := json.Unmarshal([]byte(input), &p); err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(p.Location.Geometry, parse(t, "POINT (0 0)")); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(string(data), input); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
@@ -10,3 +10,55 @@ type CircularString struct {
 func (p *CircularString) GetGeometryType() Type {
 	return CircularStringGT
 }
+
+// CoordType returns coordinate type
+func (p *CircularString) CoordType() CoordinateType {
+	return p.Type
+}
+
+// IsEmpty reports whether circularString has no points
+func (p *CircularString) IsEmpty() bool {
+	return p.Type == Empty || len(p.Points) == 0
+}
+
+// Dimension returns topological dimension
+func (p *CircularString) Dimension() int {
+	return 1
+}
+
+// NumPoints returns number of points
+func (p *CircularString) NumPoints() int {
+	return len(p.Points)
+}
+
+// Clone returns deep copy of circularString
+func (p *CircularString) Clone() *CircularString {
+	if p == nil {
+		return nil
+	}
+	return &CircularString{Points: clonePoints(p.Points), Type: p.Type}
+}
+
+// Equal reports whether g is a circularString with the same coordinate type and points
+func (p *CircularString) Equal(g Geometry) bool {
+	return p.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a circularString with the same coordinate type and points
+// which coordinates differ no more than tolerance
+func (p *CircularString) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*CircularString)
+	if !ok || p == nil || other == nil {
+		return ok && p == nil && other == nil
+	}
+
+	if p.IsEmpty() || other.IsEmpty() {
+		return p.IsEmpty() == other.IsEmpty()
+	}
+	return p.Type == other.Type && pointsEqualWithin(p.Points, other.Points, tolerance)
+}
+
+// Bounds returns envelope of circularString
+func (p *CircularString) Bounds() Envelope {
+	return arcsBounds(p.Points)
+}
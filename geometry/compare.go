@@ -0,0 +1,80 @@
+package geometry
+
+import "math"
+
+// comparer is implemented by geometries which can be compared.
+//
+// Note that github.com/google/go-cmp uses Equal methods of geometries instead of comparing fields,
+// so Equal methods must accept nil receivers and arguments.
+type comparer interface {
+	EqualWithin(g Geometry, tolerance float64) bool
+}
+
+// Clone returns deep copy of geometry, referenced geometry keeps its srid.
+// Geometries of types which are not defined in this package are returned as is.
+func Clone(g Geometry) Geometry {
+	switch geom := g.(type) {
+	case *Point:
+		return geom.Clone()
+	case *MultiPoint:
+		return geom.Clone()
+	case *LineString:
+		return geom.Clone()
+	case *CircularString:
+		return geom.Clone()
+	case *MultiLineString:
+		return geom.Clone()
+	case *Polygon:
+		return geom.Clone()
+	case *MultiPolygon:
+		return geom.Clone()
+	case *Referenced:
+		return geom.Clone()
+	default:
+		return g
+	}
+}
+
+// Equal reports whether geometries have the same type, coordinate type and coordinates.
+// Empty geometries of the same type are equal.
+func Equal(a, b Geometry) bool {
+	return EqualWithin(a, b, 0)
+}
+
+// EqualWithin reports whether geometries have the same type, coordinate type and coordinates
+// which differ no more than tolerance. Empty geometries of the same type are equal.
+func EqualWithin(a, b Geometry, tolerance float64) bool {
+	if c, ok := a.(comparer); ok {
+		return c.EqualWithin(b, tolerance)
+	}
+	return a == b
+}
+
+func within(a, b, tolerance float64) bool {
+	return a == b || math.Abs(a-b) <= tolerance
+}
+
+func clonePoints(points []*Point) []*Point {
+	if points == nil {
+		return nil
+	}
+
+	clone := make([]*Point, 0, len(points))
+	for _, point := range points {
+		clone = append(clone, point.Clone())
+	}
+	return clone
+}
+
+func pointsEqualWithin(a, b []*Point, tolerance float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, point := range a {
+		if !point.EqualWithin(b[i], tolerance) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func TestEqual(t *testing.T) {
+	polygon := &geometry.Polygon{
+		Type: geometry.XYZ,
+		Rings: []*geometry.LinearRing{
+			{
+				Type: geometry.XYZ,
+				Points: []*geometry.Point{
+					{X: 0, Y: 0, Z: 1, Type: geometry.XYZ},
+					{X: 1, Y: 0, Z: 1, Type: geometry.XYZ},
+					{X: 1, Y: 1, Z: 1, Type: geometry.XYZ},
+					{X: 0, Y: 0, Z: 1, Type: geometry.XYZ},
+				},
+			},
+		},
+	}
+
+	shifted := polygon.Clone()
+	shifted.Rings[0].Points[1].Z = 1.001
+
+	testCases := []struct {
+		Name      string
+		A, B      geometry.Geometry
+		Tolerance float64
+		Expected  bool
+	}{
+		{Name: "Clone", A: polygon, B: geometry.Clone(polygon), Expected: true},
+		{Name: "Shifted", A: polygon, B: shifted, Expected: false},
+		{Name: "Shifted within tolerance", A: polygon, B: shifted, Tolerance: 0.01, Expected: true},
+		{
+			Name:     "Different coordinate types",
+			A:        &geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+			B:        &geometry.Point{X: 1, Y: 2, Type: geometry.XYM},
+			Expected: false,
+		},
+		{
+			Name:     "Unused coordinates are ignored",
+			A:        &geometry.Point{X: 1, Y: 2, Z: 3, Type: geometry.XYM},
+			B:        &geometry.Point{X: 1, Y: 2, Type: geometry.XYM},
+			Expected: true,
+		},
+		{
+			Name:     "Empty geometries",
+			A:        &geometry.LineString{Type: geometry.Empty},
+			B:        &geometry.LineString{Type: geometry.XY},
+			Expected: true,
+		},
+		{
+			Name:     "Empty and not empty",
+			A:        &geometry.Point{Type: geometry.Empty},
+			B:        &geometry.Point{Type: geometry.XY},
+			Expected: false,
+		},
+		{
+			Name:     "Typed nil point",
+			A:        &geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+			B:        (*geometry.Point)(nil),
+			Expected: false,
+		},
+		{
+			Name:     "Nil points",
+			A:        (*geometry.Point)(nil),
+			B:        (*geometry.Point)(nil),
+			Expected: true,
+		},
+		{
+			Name:     "Nil member point",
+			A:        &geometry.MultiPoint{Type: geometry.XY, Points: []*geometry.Point{nil}},
+			B:        &geometry.MultiPoint{Type: geometry.XY, Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}}},
+			Expected: false,
+		},
+		{
+			Name:     "Referenced clone",
+			A:        geometry.WithSRID(polygon, 4326),
+			B:        geometry.Clone(geometry.WithSRID(polygon, 4326)),
+			Expected: true,
+		},
+		{
+			Name:     "Different SRIDs",
+			A:        geometry.WithSRID(polygon, 4326),
+			B:        geometry.WithSRID(polygon, 3857),
+			Expected: false,
+		},
+		{
+			Name:     "Referenced nil",
+			A:        geometry.WithSRID(nil, 4326),
+			B:        geometry.Clone(geometry.WithSRID(nil, 4326)),
+			Expected: true,
+		},
+		{
+			Name:     "Different geometry types",
+			A:        &geometry.LineString{Type: geometry.Empty},
+			B:        &geometry.CircularString{Type: geometry.Empty},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := geometry.EqualWithin(tc.A, tc.B, tc.Tolerance); got != tc.Expected {
+				t.Fatalf("\ngot: %v\nexpected: %v\n", got, tc.Expected)
+			}
+		})
+	}
+
+	if polygon.Equal(shifted) || !polygon.EqualWithin(shifted, 0.01) || !polygon.Equal(polygon.Clone()) {
+		t.Fatal("\nunexpected result of polygon methods\n")
+	}
+
+	if polygon.Rings[0].Points[1].Z != 1 {
+		t.Fatal("\nclone shares points with original\n")
+	}
+}
+
+func TestLinearRing(t *testing.T) {
+	ring := &geometry.LinearRing{
+		Type: geometry.XY,
+		Points: []*geometry.Point{
+			{X: 0, Y: 0, Type: geometry.XY},
+			{X: 0, Y: 2, Type: geometry.XY},
+			{X: 2, Y: 2, Type: geometry.XY},
+			{X: 2, Y: 0, Type: geometry.XY},
+			{X: 0, Y: 0, Type: geometry.XY},
+		},
+	}
+
+	if !ring.IsClosed() {
+		t.Fatal("\nring is not closed\n")
+	}
+
+	if area := ring.SignedArea(); area != -4 {
+		t.Fatalf("\ngot area: %v\nexpected: %v\n", area, -4)
+	}
+
+	if ring.Orientation() != geometry.Clockwise {
+		t.Fatalf("\ngot orientation: %v\nexpected: %v\n", ring.Orientation(), geometry.Clockwise)
+	}
+
+	ring.Reverse()
+	if ring.Orientation() != geometry.CounterClockwise || ring.Points[1].X != 2 {
+		t.Fatalf("\nring is not reversed: %v\n", ring.Orientation())
+	}
+
+	polygon := &geometry.Polygon{Type: geometry.XY, Rings: []*geometry.LinearRing{ring, ring.Clone()}}
+	if polygon.ExteriorRing() != ring || len(polygon.InteriorRings()) != 1 {
+		t.Fatal("\nunexpected polygon rings\n")
+	}
+}
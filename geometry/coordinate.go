@@ -1,5 +1,7 @@
 package geometry
 
+import "fmt"
+
 // CoordinateType is coordinates type such as XY, XYZ, XYM, XYZM
 type CoordinateType uint8
 
@@ -13,6 +15,74 @@ const (
 	Empty
 )
 
+// String returns name of coordinate type such as XYZ
+func (ct CoordinateType) String() string {
+	switch ct {
+	case XY:
+		return "XY"
+	case XYZ:
+		return "XYZ"
+	case XYM:
+		return "XYM"
+	case XYZM:
+		return "XYZM"
+	case Empty:
+		return "EMPTY"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+// ParseCoordinateType returns coordinate type by its name such as XYZ
+func ParseCoordinateType(name string) (CoordinateType, error) {
+	for ct := XY; ct <= Empty; ct++ {
+		if ct.String() == name {
+			return ct, nil
+		}
+	}
+	return Undefined, fmt.Errorf("%w: %s", ErrUnknownCoordinateType, name)
+}
+
+// Tag returns wkt dimension tag of coordinate type: Z, M, ZM or empty string
+func (ct CoordinateType) Tag() string {
+	switch ct {
+	case XYZ:
+		return "Z"
+	case XYM:
+		return "M"
+	case XYZM:
+		return "ZM"
+	default:
+		return ""
+	}
+}
+
+// HasZ reports whether coordinates have Z
+func (ct CoordinateType) HasZ() bool {
+	return ct == XYZ || ct == XYZM
+}
+
+// HasM reports whether coordinates have M
+func (ct CoordinateType) HasM() bool {
+	return ct == XYM || ct == XYZM
+}
+
+// NumCoordinates returns count of coordinates of a point
+func (ct CoordinateType) NumCoordinates() NumberOfCoordinates {
+	switch ct {
+	case XY:
+		return NumXY
+	case XYZ:
+		return NumXYZ
+	case XYM:
+		return NumXYM
+	case XYZM:
+		return NumXYZM
+	default:
+		return NumUndefined
+	}
+}
+
 // NumberOfCoordinates is a count coordinates for different coordinates types
 type NumberOfCoordinates int
 
@@ -23,3 +93,9 @@ const (
 	NumXYM       NumberOfCoordinates = 3
 	NumXYZM      NumberOfCoordinates = 4
 )
+
+// CoordinateText is the original decimal text of point coordinates.
+// Absent coordinates are empty strings.
+type CoordinateText struct {
+	X, Y, Z, M string
+}
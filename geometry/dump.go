@@ -0,0 +1,93 @@
+package geometry
+
+// Dumped is a part of geometry with its path.
+//
+// Path is indexes of the part in the original geometry, e.g. [1 0] is the first ring of the second polygon.
+// The part shares memory with the original geometry, use Clone to modify it independently.
+type Dumped struct {
+	Path     []int
+	Geometry Geometry
+}
+
+// Dump returns atomic parts of geometry, like ST_Dump.
+//
+// Parts of multi geometries have path [i], other geometries are returned as is with empty path.
+// Empty and nil geometries have no parts. Parts of referenced geometry are referenced to the same srid.
+func Dump(g Geometry) []Dumped {
+	if r, ok := g.(*Referenced); ok && !r.IsEmpty() {
+		return referenceParts(Dump(r.Geometry), r.SRID)
+	}
+
+	if IsNil(g) || g.IsEmpty() {
+		return nil
+	}
+
+	var dumped []Dumped
+	switch geom := g.(type) {
+	case *MultiPoint:
+		for i, point := range geom.Points {
+			dumped = append(dumped, Dumped{Path: []int{i}, Geometry: point})
+		}
+	case *MultiLineString:
+		for i, line := range geom.Lines {
+			dumped = append(dumped, Dumped{Path: []int{i}, Geometry: line})
+		}
+	case *MultiPolygon:
+		for i, polygon := range geom.Polygons {
+			dumped = append(dumped, Dumped{Path: []int{i}, Geometry: polygon})
+		}
+	default:
+		dumped = append(dumped, Dumped{Path: []int{}, Geometry: g})
+	}
+	return dumped
+}
+
+// DumpRings returns rings of polygons as polygons without holes, like ST_DumpRings.
+//
+// Rings of polygon have path [ring], rings of multipolygon have path [polygon ring].
+// Other geometries have no rings.
+func DumpRings(g Geometry) []Dumped {
+	if r, ok := g.(*Referenced); ok && !r.IsEmpty() {
+		return referenceParts(DumpRings(r.Geometry), r.SRID)
+	}
+
+	var dumped []Dumped
+	switch geom := g.(type) {
+	case *Polygon:
+		dumped = appendRings(dumped, nil, geom)
+	case *MultiPolygon:
+		for i, polygon := range geom.Polygons {
+			dumped = appendRings(dumped, []int{i}, polygon)
+		}
+	}
+	return dumped
+}
+
+func appendRings(dumped []Dumped, path []int, polygon *Polygon) []Dumped {
+	if polygon == nil || polygon.IsEmpty() {
+		return dumped
+	}
+
+	for i, ring := range polygon.Rings {
+		ringPath := append(append(make([]int, 0, len(path)+1), path...), i)
+		dumped = append(dumped, Dumped{Path: ringPath, Geometry: &Polygon{Type: polygon.Type, Rings: []*LinearRing{ring}}})
+	}
+	return dumped
+}
+
+// DumpPoints returns all points of geometry, like ST_DumpPoints.
+// Path of a point is the same as in Walk.
+func DumpPoints(g Geometry) []Dumped {
+	if r, ok := g.(*Referenced); ok && !r.IsEmpty() {
+		return referenceParts(DumpPoints(r.Geometry), r.SRID)
+	}
+
+	var dumped []Dumped
+	_ = Walk(g, func(path []int, part Geometry) error {
+		if point, ok := part.(*Point); ok && !point.IsEmpty() {
+			dumped = append(dumped, Dumped{Path: append(make([]int, 0, len(path)), path...), Geometry: point})
+		}
+		return nil
+	})
+	return dumped
+}
@@ -0,0 +1,122 @@
+package geometry_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func describe(dumped []geometry.Dumped) []string {
+	descriptions := make([]string, 0, len(dumped))
+	for _, d := range dumped {
+		description := fmt.Sprintf("%v %s %d", d.Path, d.Geometry.GetGeometryType(), d.Geometry.NumPoints())
+		if r, ok := d.Geometry.(*geometry.Referenced); ok {
+			description += fmt.Sprintf(" SRID=%d", r.SRID)
+		}
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+func TestDump(t *testing.T) {
+	multiPolygon := testMultiPolygon()
+	multiPolygon.Polygons[1].Rings = append(multiPolygon.Polygons[1].Rings, multiPolygon.Polygons[0].Rings[0])
+
+	testCases := []struct {
+		Name     string
+		Dump     func(g geometry.Geometry) []geometry.Dumped
+		Geometry geometry.Geometry
+		Expected []string
+	}{
+		{
+			Name:     "Dump multipolygon",
+			Dump:     geometry.Dump,
+			Geometry: multiPolygon,
+			Expected: []string{"[0] POLYGON 3", "[1] POLYGON 6"},
+		},
+		{
+			Name:     "Dump linestring",
+			Dump:     geometry.Dump,
+			Geometry: multiPolygon.Polygons[0].Rings[0].LineString(),
+			Expected: []string{"[] LINESTRING 3"},
+		},
+		{
+			Name:     "Dump empty",
+			Dump:     geometry.Dump,
+			Geometry: &geometry.MultiPoint{Type: geometry.Empty},
+			Expected: []string{},
+		},
+		{
+			Name:     "DumpRings multipolygon",
+			Dump:     geometry.DumpRings,
+			Geometry: multiPolygon,
+			Expected: []string{"[0 0] POLYGON 3", "[1 0] POLYGON 3", "[1 1] POLYGON 3"},
+		},
+		{
+			Name:     "DumpRings polygon",
+			Dump:     geometry.DumpRings,
+			Geometry: multiPolygon.Polygons[1],
+			Expected: []string{"[0] POLYGON 3", "[1] POLYGON 3"},
+		},
+		{
+			Name:     "DumpPoints polygon",
+			Dump:     geometry.DumpPoints,
+			Geometry: multiPolygon.Polygons[0],
+			Expected: []string{"[0 0] POINT 1", "[0 1] POINT 1", "[0 2] POINT 1"},
+		},
+		{
+			Name:     "Dump referenced multipolygon",
+			Dump:     geometry.Dump,
+			Geometry: geometry.WithSRID(multiPolygon, 4326),
+			Expected: []string{"[0] POLYGON 3 SRID=4326", "[1] POLYGON 6 SRID=4326"},
+		},
+		{
+			Name:     "DumpPoints referenced point",
+			Dump:     geometry.DumpPoints,
+			Geometry: geometry.WithSRID(&geometry.Point{X: 1, Y: 2, Type: geometry.XY}, 3857),
+			Expected: []string{"[] POINT 1 SRID=3857"},
+		},
+		{
+			Name:     "Dump referenced nil",
+			Dump:     geometry.Dump,
+			Geometry: geometry.WithSRID(nil, 4326),
+			Expected: []string{},
+		},
+		{
+			Name:     "Dump nil",
+			Dump:     geometry.Dump,
+			Geometry: nil,
+			Expected: []string{},
+		},
+		{
+			Name:     "Dump typed nil",
+			Dump:     geometry.Dump,
+			Geometry: (*geometry.MultiPolygon)(nil),
+			Expected: []string{},
+		},
+		{
+			Name:     "DumpRings typed nil",
+			Dump:     geometry.DumpRings,
+			Geometry: &geometry.MultiPolygon{Type: geometry.XY, Polygons: []*geometry.Polygon{nil}},
+			Expected: []string{},
+		},
+		{
+			Name:     "DumpPoints typed nil",
+			Dump:     geometry.DumpPoints,
+			Geometry: (*geometry.LineString)(nil),
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if diff := cmp.Diff(describe(tc.Dump(tc.Geometry)), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package geometry
+
+import "math"
+
+// Envelope is a bounding box of geometry.
+//
+// Z and M ranges are valid only if HasZ and HasM are set. Use EmptyEnvelope for an envelope without points,
+// the zero value is an envelope of the point (0 0).
+type Envelope struct {
+	MinX, MinY, MaxX, MaxY float64
+	MinZ, MaxZ             float64
+	MinM, MaxM             float64
+	HasZ, HasM             bool
+}
+
+// EmptyEnvelope returns envelope without points
+func EmptyEnvelope() Envelope {
+	inf := math.Inf(1)
+	return Envelope{MinX: inf, MinY: inf, MaxX: -inf, MaxY: -inf, MinZ: inf, MaxZ: -inf, MinM: inf, MaxM: -inf}
+}
+
+// IsEmpty reports whether envelope has no points
+func (e Envelope) IsEmpty() bool {
+	return e.MinX > e.MaxX || e.MinY > e.MaxY
+}
+
+// ExtendToPoint returns envelope extended to contain the point
+func (e Envelope) ExtendToPoint(p *Point) Envelope {
+	if p.IsEmpty() {
+		return e
+	}
+
+	e.MinX, e.MaxX = math.Min(e.MinX, p.X), math.Max(e.MaxX, p.X)
+	e.MinY, e.MaxY = math.Min(e.MinY, p.Y), math.Max(e.MaxY, p.Y)
+	if p.Type.HasZ() {
+		e.HasZ = true
+		e.MinZ, e.MaxZ = math.Min(e.MinZ, p.Z), math.Max(e.MaxZ, p.Z)
+	}
+	if p.Type.HasM() {
+		e.HasM = true
+		e.MinM, e.MaxM = math.Min(e.MinM, p.M), math.Max(e.MaxM, p.M)
+	}
+	return e
+}
+
+// Union returns envelope containing both envelopes
+func (e Envelope) Union(other Envelope) Envelope {
+	switch {
+	case other.IsEmpty():
+		return e
+	case e.IsEmpty():
+		return other
+	}
+
+	e.MinX, e.MaxX = math.Min(e.MinX, other.MinX), math.Max(e.MaxX, other.MaxX)
+	e.MinY, e.MaxY = math.Min(e.MinY, other.MinY), math.Max(e.MaxY, other.MaxY)
+	switch {
+	case e.HasZ && other.HasZ:
+		e.MinZ, e.MaxZ = math.Min(e.MinZ, other.MinZ), math.Max(e.MaxZ, other.MaxZ)
+	case other.HasZ:
+		e.HasZ, e.MinZ, e.MaxZ = true, other.MinZ, other.MaxZ
+	}
+	switch {
+	case e.HasM && other.HasM:
+		e.MinM, e.MaxM = math.Min(e.MinM, other.MinM), math.Max(e.MaxM, other.MaxM)
+	case other.HasM:
+		e.HasM, e.MinM, e.MaxM = true, other.MinM, other.MaxM
+	}
+	return e
+}
+
+// Intersects reports whether envelopes have common points in XY plane
+func (e Envelope) Intersects(other Envelope) bool {
+	if e.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+	return e.MinX <= other.MaxX && other.MinX <= e.MaxX && e.MinY <= other.MaxY && other.MinY <= e.MaxY
+}
+
+// Contains reports whether other envelope lies inside envelope in XY plane
+func (e Envelope) Contains(other Envelope) bool {
+	if e.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+	return e.MinX <= other.MinX && other.MaxX <= e.MaxX && e.MinY <= other.MinY && other.MaxY <= e.MaxY
+}
+
+// ContainsPoint reports whether point (x y) lies inside envelope
+func (e Envelope) ContainsPoint(x, y float64) bool {
+	return e.MinX <= x && x <= e.MaxX && e.MinY <= y && y <= e.MaxY
+}
+
+// Expand returns envelope grown by distance in every direction of XY plane
+func (e Envelope) Expand(distance float64) Envelope {
+	if e.IsEmpty() {
+		return e
+	}
+
+	e.MinX, e.MinY = e.MinX-distance, e.MinY-distance
+	e.MaxX, e.MaxY = e.MaxX+distance, e.MaxY+distance
+	return e
+}
+
+// Center returns center of envelope in XY plane
+func (e Envelope) Center() (x, y float64) {
+	return (e.MinX + e.MaxX) / 2, (e.MinY + e.MaxY) / 2
+}
+
+func pointsBounds(points []*Point) Envelope {
+	e := EmptyEnvelope()
+	for _, point := range points {
+		e = e.ExtendToPoint(point)
+	}
+	return e
+}
+
+// arcsBounds returns envelope of circular arcs defined by every three consecutive points
+// with the shared end point, including extreme points of arcs
+func arcsBounds(points []*Point) Envelope {
+	e := pointsBounds(points)
+	for i := 0; i+2 < len(points); i += 2 {
+		e = e.Union(arcBounds(points[i], points[i+1], points[i+2]))
+	}
+	return e
+}
+
+func arcBounds(p0, p1, p2 *Point) Envelope {
+	e := EmptyEnvelope().ExtendToPoint(p0).ExtendToPoint(p1).ExtendToPoint(p2)
+
+	cx, cy, r, ok := circumcircle(p0, p1, p2)
+	if !ok {
+		return e
+	}
+
+	full := p0.X == p2.X && p0.Y == p2.Y
+	ccw := (p1.X-p0.X)*(p2.Y-p0.Y)-(p1.Y-p0.Y)*(p2.X-p0.X) > 0
+	a0, a2 := math.Atan2(p0.Y-cy, p0.X-cx), math.Atan2(p2.Y-cy, p2.X-cx)
+	for quadrant := 0; quadrant < 4; quadrant++ {
+		angle := float64(quadrant) * math.Pi / 2
+		if !full && !onArc(angle, a0, a2, ccw) {
+			continue
+		}
+
+		x, y := cx+r*math.Cos(angle), cy+r*math.Sin(angle)
+		e.MinX, e.MaxX = math.Min(e.MinX, x), math.Max(e.MaxX, x)
+		e.MinY, e.MaxY = math.Min(e.MinY, y), math.Max(e.MaxY, y)
+	}
+	return e
+}
+
+// circumcircle returns center and radius of circle through three points,
+// ok is false if points are collinear
+func circumcircle(p0, p1, p2 *Point) (cx, cy, r float64, ok bool) {
+	if p0.X == p2.X && p0.Y == p2.Y {
+		// full circle, p1 is opposite to p0
+		cx, cy = (p0.X+p1.X)/2, (p0.Y+p1.Y)/2
+		r = math.Hypot(p0.X-cx, p0.Y-cy)
+		return cx, cy, r, r > 0
+	}
+
+	bx, by := p1.X-p0.X, p1.Y-p0.Y
+	qx, qy := p2.X-p0.X, p2.Y-p0.Y
+	d := 2 * (bx*qy - by*qx)
+	if d == 0 {
+		return 0, 0, 0, false
+	}
+
+	b2, q2 := bx*bx+by*by, qx*qx+qy*qy
+	ux, uy := (qy*b2-by*q2)/d, (bx*q2-qx*b2)/d
+	return p0.X + ux, p0.Y + uy, math.Hypot(ux, uy), true
+}
+
+// onArc reports whether angle lies on arc from a0 to a2 in the specified direction
+func onArc(angle, a0, a2 float64, ccw bool) bool {
+	if ccw {
+		return normalizeAngle(angle-a0) <= normalizeAngle(a2-a0)
+	}
+	return normalizeAngle(a0-angle) <= normalizeAngle(a0-a2)
+}
+
+// normalizeAngle returns angle in range [0, 2π)
+func normalizeAngle(angle float64) float64 {
+	angle = math.Mod(angle, 2*math.Pi)
+	if angle < 0 {
+		angle += 2 * math.Pi
+	}
+	return angle
+}
@@ -0,0 +1,109 @@
+package geometry_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func TestBounds(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Geometry geometry.Geometry
+		Expected geometry.Envelope
+	}{
+		{
+			Name:     "Point Z",
+			Geometry: &geometry.Point{X: 1, Y: 2, Z: 3, Type: geometry.XYZ},
+			Expected: geometry.Envelope{MinX: 1, MinY: 2, MaxX: 1, MaxY: 2, MinZ: 3, MaxZ: 3, HasZ: true, MinM: math.Inf(1), MaxM: math.Inf(-1)},
+		},
+		{
+			Name: "LineString",
+			Geometry: &geometry.LineString{
+				Type: geometry.XY,
+				Points: []*geometry.Point{
+					{X: 1, Y: 5, Type: geometry.XY},
+					{X: -3, Y: 2, Type: geometry.XY},
+				},
+			},
+			Expected: geometry.Envelope{MinX: -3, MinY: 2, MaxX: 1, MaxY: 5, MinZ: math.Inf(1), MaxZ: math.Inf(-1), MinM: math.Inf(1), MaxM: math.Inf(-1)},
+		},
+		{
+			Name: "Half circle arc",
+			Geometry: &geometry.CircularString{
+				Type: geometry.XY,
+				Points: []*geometry.Point{
+					{X: -1, Y: 0, Type: geometry.XY},
+					{X: 0, Y: -1, Type: geometry.XY},
+					{X: 1, Y: 0, Type: geometry.XY},
+				},
+			},
+			Expected: geometry.Envelope{MinX: -1, MinY: -1, MaxX: 1, MaxY: 0, MinZ: math.Inf(1), MaxZ: math.Inf(-1), MinM: math.Inf(1), MaxM: math.Inf(-1)},
+		},
+		{
+			Name: "Quarter circle arc",
+			Geometry: &geometry.CircularString{
+				Type: geometry.XY,
+				Points: []*geometry.Point{
+					{X: 1, Y: 0, Type: geometry.XY},
+					{X: math.Sqrt2 / 2, Y: math.Sqrt2 / 2, Type: geometry.XY},
+					{X: 0, Y: 1, Type: geometry.XY},
+				},
+			},
+			Expected: geometry.Envelope{MinX: 0, MinY: 0, MaxX: 1, MaxY: 1, MinZ: math.Inf(1), MaxZ: math.Inf(-1), MinM: math.Inf(1), MaxM: math.Inf(-1)},
+		},
+		{
+			Name: "Full circle",
+			Geometry: &geometry.CircularString{
+				Type: geometry.XY,
+				Points: []*geometry.Point{
+					{X: 2, Y: 0, Type: geometry.XY},
+					{X: 4, Y: 0, Type: geometry.XY},
+					{X: 2, Y: 0, Type: geometry.XY},
+				},
+			},
+			Expected: geometry.Envelope{MinX: 2, MinY: -1, MaxX: 4, MaxY: 1, MinZ: math.Inf(1), MaxZ: math.Inf(-1), MinM: math.Inf(1), MaxM: math.Inf(-1)},
+		},
+		{
+			Name:     "Empty polygon",
+			Geometry: &geometry.Polygon{Type: geometry.Empty},
+			Expected: geometry.EmptyEnvelope(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.Geometry.Bounds(), tc.Expected, cmpopts.EquateApprox(0, 1e-12)); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestEnvelope(t *testing.T) {
+	a := geometry.Envelope{MinX: 0, MinY: 0, MaxX: 2, MaxY: 2}
+	b := geometry.Envelope{MinX: 1, MinY: 1, MaxX: 3, MaxY: 3}
+	c := geometry.Envelope{MinX: 5, MinY: 5, MaxX: 6, MaxY: 6}
+
+	if !a.Intersects(b) || a.Intersects(c) || a.Intersects(geometry.EmptyEnvelope()) {
+		t.Fatal("\nunexpected Intersects result\n")
+	}
+
+	union := a.Union(c).Union(geometry.EmptyEnvelope())
+	if diff := cmp.Diff(union, geometry.Envelope{MinX: 0, MinY: 0, MaxX: 6, MaxY: 6}); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if !union.Contains(b) || b.Contains(union) {
+		t.Fatal("\nunexpected Contains result\n")
+	}
+
+	if x, y := a.Expand(1).Center(); x != 1 || y != 1 || a.Expand(1).MinX != -1 {
+		t.Fatalf("\nunexpected expanded envelope: %+v\n", a.Expand(1))
+	}
+}
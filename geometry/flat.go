@@ -0,0 +1,134 @@
+package geometry
+
+// Flat is a compact representation of geometry.
+//
+// Coordinates of all points are stored in one Sequence and the nesting is described by end indexes of points:
+//   - Point, MultiPoint, LineString and CircularString use only Coords;
+//   - MultiLineString and Polygon use Ends, the end index of every line or ring;
+//   - MultiPolygon uses Endss, the Ends of every polygon.
+type Flat struct {
+	GeomType Type
+	Coords   Sequence
+	Ends     []int
+	Endss    [][]int
+}
+
+// IsEmpty reports whether flat geometry has no points
+func (f *Flat) IsEmpty() bool {
+	return f.Coords.Type == Empty || f.Coords.Len() == 0
+}
+
+// Flatten returns compact representation of geometry.
+// Referenced geometry is flattened as the geometry it wraps, Flat has no SRID.
+func Flatten(g Geometry) *Flat {
+	if r, ok := g.(*Referenced); ok && r != nil && r.Geometry != nil {
+		return Flatten(r.Geometry)
+	}
+
+	f := &Flat{GeomType: g.GetGeometryType(), Coords: Sequence{Type: g.CoordType()}}
+	f.Coords.Coords = make([]float64, 0, g.NumPoints()*f.Coords.Stride())
+
+	switch geom := g.(type) {
+	case *Point:
+		if !geom.IsEmpty() {
+			f.Coords.AppendPoint(geom)
+		}
+	case *MultiPoint:
+		f.appendPoints(geom.Points)
+	case *LineString:
+		f.appendPoints(geom.Points)
+	case *CircularString:
+		f.appendPoints(geom.Points)
+	case *MultiLineString:
+		f.Ends = f.appendLines(geom.Lines)
+	case *Polygon:
+		f.Ends = f.appendRings(geom.Rings)
+	case *MultiPolygon:
+		for _, polygon := range geom.Polygons {
+			f.Endss = append(f.Endss, f.appendRings(polygon.Rings))
+		}
+	}
+	return f
+}
+
+func (f *Flat) appendPoints(points []*Point) {
+	for _, point := range points {
+		f.Coords.AppendPoint(point)
+	}
+}
+
+func (f *Flat) appendLines(lines []*LineString) []int {
+	ends := make([]int, 0, len(lines))
+	for _, line := range lines {
+		f.appendPoints(line.Points)
+		ends = append(ends, f.Coords.Len())
+	}
+	return ends
+}
+
+func (f *Flat) appendRings(rings []*LinearRing) []int {
+	ends := make([]int, 0, len(rings))
+	for _, ring := range rings {
+		f.appendPoints(ring.Points)
+		ends = append(ends, f.Coords.Len())
+	}
+	return ends
+}
+
+// Geometry converts flat geometry to the regular geometry type
+func (f *Flat) Geometry() Geometry {
+	ct := f.Coords.Type
+	switch f.GeomType {
+	case PointGT:
+		if f.IsEmpty() {
+			return &Point{Type: Empty}
+		}
+		return f.Coords.Point(0)
+	case MultiPointGT:
+		return &MultiPoint{Type: ct, Points: f.points(0, f.Coords.Len())}
+	case LineStringGT:
+		return &LineString{Type: ct, Points: f.points(0, f.Coords.Len())}
+	case CircularStringGT:
+		return &CircularString{Type: ct, Points: f.points(0, f.Coords.Len())}
+	case MultiLineStringGT:
+		return &MultiLineString{Type: ct, Lines: f.lines(0, f.Ends)}
+	case PolygonGT:
+		return &Polygon{Type: ct, Rings: f.rings(0, f.Ends)}
+	case MultiPolygonGT:
+		multiPolygon := &MultiPolygon{Type: ct}
+		from := 0
+		for _, ends := range f.Endss {
+			multiPolygon.Polygons = append(multiPolygon.Polygons, &Polygon{Type: ct, Rings: f.rings(from, ends)})
+			if len(ends) > 0 {
+				from = ends[len(ends)-1]
+			}
+		}
+		return multiPolygon
+	default:
+		return nil
+	}
+}
+
+func (f *Flat) points(from, to int) []*Point {
+	if from == to {
+		return nil
+	}
+	return f.Coords.Points(from, to)
+}
+
+func (f *Flat) lines(from int, ends []int) []*LineString {
+	var lines []*LineString
+	for _, end := range ends {
+		lines = append(lines, &LineString{Type: f.Coords.Type, Points: f.points(from, end)})
+		from = end
+	}
+	return lines
+}
+
+func (f *Flat) rings(from int, ends []int) []*LinearRing {
+	var rings []*LinearRing
+	for _, line := range f.lines(from, ends) {
+		rings = append(rings, (*LinearRing)(line))
+	}
+	return rings
+}
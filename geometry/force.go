@@ -0,0 +1,81 @@
+package geometry
+
+// Force2D returns copy of geometry with XY coordinates, Z and M are dropped
+func Force2D(g Geometry) Geometry {
+	return force(g, XY, 0, 0)
+}
+
+// Force3DZ returns copy of geometry with XYZ coordinates.
+// M is dropped, absent Z is set to the specified value.
+func Force3DZ(g Geometry, z float64) Geometry {
+	return force(g, XYZ, z, 0)
+}
+
+// Force3DM returns copy of geometry with XYM coordinates.
+// Z is dropped, absent M is set to the specified value.
+func Force3DM(g Geometry, m float64) Geometry {
+	return force(g, XYM, 0, m)
+}
+
+// Force4D returns copy of geometry with XYZM coordinates, absent Z and M are set to the specified values
+func Force4D(g Geometry, z, m float64) Geometry {
+	return force(g, XYZM, z, m)
+}
+
+// force returns copy of geometry with the coordinate type set for geometry and all its parts.
+// Empty geometries stay empty and nil geometry is returned as nil.
+func force(g Geometry, ct CoordinateType, z, m float64) Geometry {
+	if IsNil(g) {
+		return nil
+	}
+
+	clone := Clone(g)
+	_ = Walk(clone, func(_ []int, part Geometry) error {
+		if IsNil(part) || part.CoordType() == Empty {
+			return ErrSkipParts
+		}
+
+		switch geom := part.(type) {
+		case *Point:
+			forcePoint(geom, ct, z, m)
+		case *MultiPoint:
+			geom.Type = ct
+		case *LineString:
+			geom.Type = ct
+		case *CircularString:
+			geom.Type = ct
+		case *MultiLineString:
+			geom.Type = ct
+		case *Polygon:
+			geom.Type = ct
+		case *MultiPolygon:
+			geom.Type = ct
+		}
+		return nil
+	})
+	return clone
+}
+
+func forcePoint(p *Point, ct CoordinateType, z, m float64) {
+	switch {
+	case !ct.HasZ():
+		p.Z = 0
+		if p.Text != nil {
+			p.Text.Z = ""
+		}
+	case !p.Type.HasZ():
+		p.Z = z
+	}
+
+	switch {
+	case !ct.HasM():
+		p.M = 0
+		if p.Text != nil {
+			p.Text.M = ""
+		}
+	case !p.Type.HasM():
+		p.M = m
+	}
+
+	p.Type = ct
+}
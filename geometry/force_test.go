@@ -0,0 +1,132 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func TestForce(t *testing.T) {
+	lineXYM := &geometry.LineString{
+		Type: geometry.XYM,
+		Points: []*geometry.Point{
+			{X: 1, Y: 2, M: 3, Type: geometry.XYM},
+			{X: 4, Y: 5, M: 6, Type: geometry.XYM},
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Force    func(g geometry.Geometry) geometry.Geometry
+		Input    geometry.Geometry
+		Expected geometry.Geometry
+	}{
+		{
+			Name:  "Force2D",
+			Force: geometry.Force2D,
+			Input: lineXYM,
+			Expected: &geometry.LineString{
+				Type: geometry.XY,
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Type: geometry.XY},
+					{X: 4, Y: 5, Type: geometry.XY},
+				},
+			},
+		},
+		{
+			Name:  "Force3DZ",
+			Force: func(g geometry.Geometry) geometry.Geometry { return geometry.Force3DZ(g, 9) },
+			Input: lineXYM,
+			Expected: &geometry.LineString{
+				Type: geometry.XYZ,
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Z: 9, Type: geometry.XYZ},
+					{X: 4, Y: 5, Z: 9, Type: geometry.XYZ},
+				},
+			},
+		},
+		{
+			Name:  "Force3DM",
+			Force: func(g geometry.Geometry) geometry.Geometry { return geometry.Force3DM(g, 9) },
+			Input: &geometry.Point{X: 1, Y: 2, Z: 3, Type: geometry.XYZ},
+			Expected: &geometry.Point{
+				X: 1, Y: 2, M: 9, Type: geometry.XYM,
+			},
+		},
+		{
+			Name:  "Force4D",
+			Force: func(g geometry.Geometry) geometry.Geometry { return geometry.Force4D(g, 7, 9) },
+			Input: lineXYM,
+			Expected: &geometry.LineString{
+				Type: geometry.XYZM,
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Z: 7, M: 3, Type: geometry.XYZM},
+					{X: 4, Y: 5, Z: 7, M: 6, Type: geometry.XYZM},
+				},
+			},
+		},
+		{
+			Name:  "Referenced",
+			Force: geometry.Force2D,
+			Input: geometry.WithSRID(lineXYM, 4326),
+			Expected: geometry.WithSRID(&geometry.LineString{
+				Type: geometry.XY,
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Type: geometry.XY},
+					{X: 4, Y: 5, Type: geometry.XY},
+				},
+			}, 4326),
+		},
+		{
+			Name:     "Referenced nil",
+			Force:    geometry.Force2D,
+			Input:    geometry.WithSRID(nil, 4326),
+			Expected: geometry.WithSRID(nil, 4326),
+		},
+		{
+			Name:     "Nil",
+			Force:    geometry.Force2D,
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Name:     "Typed nil",
+			Force:    geometry.Force2D,
+			Input:    (*geometry.Point)(nil),
+			Expected: nil,
+		},
+		{
+			Name:  "Nil part",
+			Force: geometry.Force2D,
+			Input: &geometry.MultiPoint{
+				Type:   geometry.XYM,
+				Points: []*geometry.Point{{X: 1, Y: 2, M: 3, Type: geometry.XYM}, nil},
+			},
+			Expected: &geometry.MultiPoint{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, nil},
+			},
+		},
+		{
+			Name:     "Empty",
+			Force:    func(g geometry.Geometry) geometry.Geometry { return geometry.Force4D(g, 7, 9) },
+			Input:    &geometry.Polygon{Type: geometry.Empty},
+			Expected: &geometry.Polygon{Type: geometry.Empty},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.Force(tc.Input), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+
+	if lineXYM.Points[0].Type != geometry.XYM {
+		t.Fatal("\ninput is changed\n")
+	}
+}
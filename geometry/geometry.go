@@ -3,4 +3,38 @@ package geometry
 // Geometry is an interface for different geometry types
 type Geometry interface {
 	GetGeometryType() Type
+	// CoordType returns coordinate type of geometry
+	CoordType() CoordinateType
+	// IsEmpty reports whether geometry has no points
+	IsEmpty() bool
+	// Dimension returns topological dimension: 0 for points, 1 for curves and 2 for surfaces
+	Dimension() int
+	// NumPoints returns number of points of geometry including all its parts
+	NumPoints() int
+	// Bounds returns envelope of geometry
+	Bounds() Envelope
+}
+
+// IsNil reports whether g is nil or a nil pointer to geometry
+func IsNil(g Geometry) bool {
+	switch geom := g.(type) {
+	case *Point:
+		return geom == nil
+	case *MultiPoint:
+		return geom == nil
+	case *LineString:
+		return geom == nil
+	case *CircularString:
+		return geom == nil
+	case *MultiLineString:
+		return geom == nil
+	case *Polygon:
+		return geom == nil
+	case *MultiPolygon:
+		return geom == nil
+	case *Referenced:
+		return geom == nil
+	default:
+		return g == nil
+	}
 }
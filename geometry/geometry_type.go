@@ -1,5 +1,15 @@
 package geometry
 
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownType           = errors.New("unknown geometry type")
+	ErrUnknownCoordinateType = errors.New("unknown coordinate type")
+)
+
 // Type is geometry type such as Point, LineString, Polygon etc.
 type Type uint8
 
@@ -7,7 +17,7 @@ const (
 	UndefinedGT Type = 0 + iota
 
 	PointGT
-	MultyPointGT
+	MultiPointGT
 
 	LineStringGT
 	CircularStringGT
@@ -15,4 +25,125 @@ const (
 
 	PolygonGT
 	MultiPolygonGT
+
+	GeometryCollectionGT
+)
+
+// MultyPointGT is the former misspelled name of MultiPointGT.
+//
+// Deprecated: use MultiPointGT.
+const MultyPointGT = MultiPointGT
+
+// ISO WKB type code offsets for coordinate types
+const (
+	isoOffsetZ  = 1000
+	isoOffsetM  = 2000
+	isoOffsetZM = 3000
 )
+
+// String returns wkt name of geometry type such as POINT
+func (t Type) String() string {
+	switch t {
+	case PointGT:
+		return "POINT"
+	case MultiPointGT:
+		return "MULTIPOINT"
+	case LineStringGT:
+		return "LINESTRING"
+	case CircularStringGT:
+		return "CIRCULARSTRING"
+	case MultiLineStringGT:
+		return "MULTILINESTRING"
+	case PolygonGT:
+		return "POLYGON"
+	case MultiPolygonGT:
+		return "MULTIPOLYGON"
+	case GeometryCollectionGT:
+		return "GEOMETRYCOLLECTION"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+// ParseType returns geometry type by its upper case wkt name such as POINT
+func ParseType(name string) (Type, error) {
+	for t := PointGT; t <= GeometryCollectionGT; t++ {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+	return UndefinedGT, fmt.Errorf("%w: %s", ErrUnknownType, name)
+}
+
+// WKBCode returns OGC WKB type code, it is 0 for undefined type
+func (t Type) WKBCode() uint32 {
+	switch t {
+	case PointGT:
+		return 1
+	case LineStringGT:
+		return 2
+	case PolygonGT:
+		return 3
+	case MultiPointGT:
+		return 4
+	case MultiLineStringGT:
+		return 5
+	case MultiPolygonGT:
+		return 6
+	case GeometryCollectionGT:
+		return 7
+	case CircularStringGT:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// ISOWKBCode returns ISO WKB type code including coordinate type, e.g. 1001 for POINT Z
+func (t Type) ISOWKBCode(ct CoordinateType) uint32 {
+	code := t.WKBCode()
+	switch ct {
+	case XYZ:
+		code += isoOffsetZ
+	case XYM:
+		code += isoOffsetM
+	case XYZM:
+		code += isoOffsetZM
+	}
+	return code
+}
+
+// TypeFromWKBCode returns geometry type and coordinate type by OGC or ISO WKB type code
+func TypeFromWKBCode(code uint32) (Type, CoordinateType, error) {
+	ct := XY
+	switch {
+	case code > isoOffsetZM:
+		ct, code = XYZM, code-isoOffsetZM
+	case code > isoOffsetM:
+		ct, code = XYM, code-isoOffsetM
+	case code > isoOffsetZ:
+		ct, code = XYZ, code-isoOffsetZ
+	}
+
+	for t := PointGT; t <= GeometryCollectionGT; t++ {
+		if t.WKBCode() == code {
+			return t, ct, nil
+		}
+	}
+	return UndefinedGT, Undefined, fmt.Errorf("%w: wkb code %d", ErrUnknownType, code)
+}
+
+// IsMulti reports whether type is one of multi types such as MULTIPOINT
+func (t Type) IsMulti() bool {
+	return t == MultiPointGT || t == MultiLineStringGT || t == MultiPolygonGT
+}
+
+// IsCollection reports whether geometries of the type consist of other geometries
+func (t Type) IsCollection() bool {
+	return t.IsMulti() || t == GeometryCollectionGT
+}
+
+// IsCurve reports whether geometries of the type are made of circular arcs
+func (t Type) IsCurve() bool {
+	return t == CircularStringGT
+}
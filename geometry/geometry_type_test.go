@@ -0,0 +1,74 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func TestType(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Geometry  geometry.Geometry
+		Type      geometry.Type
+		Wkt       string
+		ISOWKB    uint32
+		Multi     bool
+		Dimension int
+	}{
+		{Name: "Point", Geometry: &geometry.Point{Type: geometry.XYZ}, Type: geometry.PointGT, Wkt: "POINT", ISOWKB: 1001},
+		{Name: "MultiPoint", Geometry: &geometry.MultiPoint{Type: geometry.XY}, Type: geometry.MultiPointGT, Wkt: "MULTIPOINT", ISOWKB: 4, Multi: true},
+		{Name: "LineString", Geometry: &geometry.LineString{Type: geometry.XYM}, Type: geometry.LineStringGT, Wkt: "LINESTRING", ISOWKB: 2002, Dimension: 1},
+		{
+			Name: "CircularString", Geometry: &geometry.CircularString{Type: geometry.XYZM}, Type: geometry.CircularStringGT,
+			Wkt: "CIRCULARSTRING", ISOWKB: 3008, Dimension: 1,
+		},
+		{
+			Name: "MultiLineString", Geometry: &geometry.MultiLineString{Type: geometry.XY}, Type: geometry.MultiLineStringGT,
+			Wkt: "MULTILINESTRING", ISOWKB: 5, Multi: true, Dimension: 1,
+		},
+		{Name: "Polygon", Geometry: &geometry.Polygon{Type: geometry.XYZ}, Type: geometry.PolygonGT, Wkt: "POLYGON", ISOWKB: 1003, Dimension: 2},
+		{
+			Name: "MultiPolygon", Geometry: &geometry.MultiPolygon{Type: geometry.XY}, Type: geometry.MultiPolygonGT,
+			Wkt: "MULTIPOLYGON", ISOWKB: 6, Multi: true, Dimension: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			gt := tc.Geometry.GetGeometryType()
+			if gt != tc.Type {
+				t.Fatalf("\ngot: %s\nexpected: %s\n", gt, tc.Type)
+			}
+
+			if gt.String() != tc.Wkt {
+				t.Fatalf("\ngot: %s\nexpected: %s\n", gt.String(), tc.Wkt)
+			}
+
+			parsed, err := geometry.ParseType(tc.Wkt)
+			if err != nil || parsed != gt {
+				t.Fatalf("\ngot: %s, %v\nexpected: %s\n", parsed, err, gt)
+			}
+
+			ct := tc.Geometry.CoordType()
+			code := gt.ISOWKBCode(ct)
+			if code != tc.ISOWKB {
+				t.Fatalf("\ngot: %d\nexpected: %d\n", code, tc.ISOWKB)
+			}
+
+			decodedType, decodedCT, err := geometry.TypeFromWKBCode(code)
+			if err != nil || decodedType != gt || decodedCT != ct {
+				t.Fatalf("\ngot: %s %s %v\nexpected: %s %s\n", decodedType, decodedCT, err, gt, ct)
+			}
+
+			if gt.IsMulti() != tc.Multi || gt.IsCollection() != tc.Multi {
+				t.Fatalf("\ngot multi: %v\nexpected: %v\n", gt.IsMulti(), tc.Multi)
+			}
+
+			if tc.Geometry.Dimension() != tc.Dimension {
+				t.Fatalf("\ngot dimension: %d\nexpected: %d\n", tc.Geometry.Dimension(), tc.Dimension)
+			}
+		})
+	}
+}
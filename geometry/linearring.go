@@ -0,0 +1,104 @@
+package geometry
+
+// Orientation is a winding order of a ring
+type Orientation int8
+
+const (
+	// Collinear is orientation of a ring with zero area
+	Collinear Orientation = 0
+	// CounterClockwise is orientation of a ring with positive signed area
+	CounterClockwise Orientation = 1
+	// Clockwise is orientation of a ring with negative signed area
+	Clockwise Orientation = -1
+)
+
+// LinearRing is a closed line string used as a polygon ring.
+//
+// It has the same fields as LineString, so a ring can be converted to *LineString and back.
+type LinearRing struct {
+	Points []*Point
+	Type   CoordinateType
+}
+
+// LineString returns the ring as line string sharing the same points
+func (r *LinearRing) LineString() *LineString {
+	return (*LineString)(r)
+}
+
+// IsEmpty reports whether linearRing has no points
+func (r *LinearRing) IsEmpty() bool {
+	return r.Type == Empty || len(r.Points) == 0
+}
+
+// NumPoints returns number of points
+func (r *LinearRing) NumPoints() int {
+	return len(r.Points)
+}
+
+// Clone returns deep copy of linearRing
+func (r *LinearRing) Clone() *LinearRing {
+	if r == nil {
+		return nil
+	}
+	return &LinearRing{Points: clonePoints(r.Points), Type: r.Type}
+}
+
+// IsClosed reports whether the first and the last points of ring are equal
+func (r *LinearRing) IsClosed() bool {
+	if r.IsEmpty() {
+		return false
+	}
+	return r.Points[0].EqualWithin(r.Points[len(r.Points)-1], 0)
+}
+
+// SignedArea returns area of ring in XY plane.
+// It is positive if ring is counter-clockwise and negative if ring is clockwise.
+func (r *LinearRing) SignedArea() float64 {
+	if len(r.Points) < 3 {
+		return 0
+	}
+
+	// shoelace formula relative to the first point to reduce rounding errors
+	x0, y0 := r.Points[0].X, r.Points[0].Y
+	area := 0.0
+	for i := 1; i < len(r.Points)-1; i++ {
+		a, b := r.Points[i], r.Points[i+1]
+		area += (a.X-x0)*(b.Y-y0) - (b.X-x0)*(a.Y-y0)
+	}
+	return area / 2
+}
+
+// Orientation returns winding order of ring
+func (r *LinearRing) Orientation() Orientation {
+	switch area := r.SignedArea(); {
+	case area > 0:
+		return CounterClockwise
+	case area < 0:
+		return Clockwise
+	default:
+		return Collinear
+	}
+}
+
+// Reverse reverses order of points in place
+func (r *LinearRing) Reverse() {
+	for i, j := 0, len(r.Points)-1; i < j; i, j = i+1, j-1 {
+		r.Points[i], r.Points[j] = r.Points[j], r.Points[i]
+	}
+}
+
+// Equal reports whether other is a ring with the same coordinate type and points
+func (r *LinearRing) Equal(other *LinearRing) bool {
+	return r.EqualWithin(other, 0)
+}
+
+// EqualWithin reports whether other is a ring with the same coordinate type and points
+// which coordinates differ no more than tolerance
+func (r *LinearRing) EqualWithin(other *LinearRing, tolerance float64) bool {
+	return r.LineString().EqualWithin(other.LineString(), tolerance)
+}
+
+// Bounds returns envelope of linearRing
+func (r *LinearRing) Bounds() Envelope {
+	return pointsBounds(r.Points)
+}
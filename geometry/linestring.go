@@ -10,3 +10,55 @@ type LineString struct {
 func (p *LineString) GetGeometryType() Type {
 	return LineStringGT
 }
+
+// CoordType returns coordinate type
+func (p *LineString) CoordType() CoordinateType {
+	return p.Type
+}
+
+// IsEmpty reports whether lineString has no points
+func (p *LineString) IsEmpty() bool {
+	return p.Type == Empty || len(p.Points) == 0
+}
+
+// Dimension returns topological dimension
+func (p *LineString) Dimension() int {
+	return 1
+}
+
+// NumPoints returns number of points
+func (p *LineString) NumPoints() int {
+	return len(p.Points)
+}
+
+// Clone returns deep copy of lineString
+func (p *LineString) Clone() *LineString {
+	if p == nil {
+		return nil
+	}
+	return &LineString{Points: clonePoints(p.Points), Type: p.Type}
+}
+
+// Equal reports whether g is a lineString with the same coordinate type and points
+func (p *LineString) Equal(g Geometry) bool {
+	return p.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a lineString with the same coordinate type and points
+// which coordinates differ no more than tolerance
+func (p *LineString) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*LineString)
+	if !ok || p == nil || other == nil {
+		return ok && p == nil && other == nil
+	}
+
+	if p.IsEmpty() || other.IsEmpty() {
+		return p.IsEmpty() == other.IsEmpty()
+	}
+	return p.Type == other.Type && pointsEqualWithin(p.Points, other.Points, tolerance)
+}
+
+// Bounds returns envelope of lineString
+func (p *LineString) Bounds() Envelope {
+	return pointsBounds(p.Points)
+}
@@ -1,11 +1,89 @@
 package geometry
 
-// MULTILINESTRING
+// MultiLineString is wkt multiLineString representation
 type MultiLineString struct {
 	Lines []*LineString
 	Type  CoordinateType
 }
 
-func (m MultiLineString) GetGeometryType() Type {
+// GetGeometryType returns geometry type
+func (m *MultiLineString) GetGeometryType() Type {
 	return MultiLineStringGT
 }
+
+// CoordType returns coordinate type
+func (m *MultiLineString) CoordType() CoordinateType {
+	return m.Type
+}
+
+// IsEmpty reports whether multiLineString has no lines
+func (m *MultiLineString) IsEmpty() bool {
+	return m.Type == Empty || len(m.Lines) == 0
+}
+
+// Dimension returns topological dimension
+func (m *MultiLineString) Dimension() int {
+	return 1
+}
+
+// NumPoints returns number of points of all lines
+func (m *MultiLineString) NumPoints() int {
+	numPoints := 0
+	for _, line := range m.Lines {
+		numPoints += line.NumPoints()
+	}
+	return numPoints
+}
+
+// Clone returns deep copy of multiLineString
+func (m *MultiLineString) Clone() *MultiLineString {
+	if m == nil {
+		return nil
+	}
+	clone := &MultiLineString{Type: m.Type}
+	if m.Lines != nil {
+		clone.Lines = make([]*LineString, 0, len(m.Lines))
+	}
+	for _, line := range m.Lines {
+		clone.Lines = append(clone.Lines, line.Clone())
+	}
+	return clone
+}
+
+// Equal reports whether g is a multiLineString with the same coordinate type and lines
+func (m *MultiLineString) Equal(g Geometry) bool {
+	return m.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a multiLineString with the same coordinate type and lines
+// which coordinates differ no more than tolerance
+func (m *MultiLineString) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*MultiLineString)
+	if !ok || m == nil || other == nil {
+		return ok && m == nil && other == nil
+	}
+
+	if m.IsEmpty() || other.IsEmpty() {
+		return m.IsEmpty() == other.IsEmpty()
+	}
+
+	if m.Type != other.Type || len(m.Lines) != len(other.Lines) {
+		return false
+	}
+
+	for i, line := range m.Lines {
+		if !line.EqualWithin(other.Lines[i], tolerance) {
+			return false
+		}
+	}
+	return true
+}
+
+// Bounds returns envelope of multiLineString
+func (m *MultiLineString) Bounds() Envelope {
+	e := EmptyEnvelope()
+	for _, line := range m.Lines {
+		e = e.Union(line.Bounds())
+	}
+	return e
+}
@@ -8,5 +8,57 @@ type MultiPoint struct {
 
 // GetGeometryType returns geometry type
 func (m *MultiPoint) GetGeometryType() Type {
-	return MultyPointGT
+	return MultiPointGT
+}
+
+// CoordType returns coordinate type
+func (m *MultiPoint) CoordType() CoordinateType {
+	return m.Type
+}
+
+// IsEmpty reports whether multiPoint has no points
+func (m *MultiPoint) IsEmpty() bool {
+	return m.Type == Empty || len(m.Points) == 0
+}
+
+// Dimension returns topological dimension
+func (m *MultiPoint) Dimension() int {
+	return 0
+}
+
+// NumPoints returns number of points
+func (m *MultiPoint) NumPoints() int {
+	return len(m.Points)
+}
+
+// Clone returns deep copy of multiPoint
+func (m *MultiPoint) Clone() *MultiPoint {
+	if m == nil {
+		return nil
+	}
+	return &MultiPoint{Points: clonePoints(m.Points), Type: m.Type}
+}
+
+// Equal reports whether g is a multiPoint with the same coordinate type and points
+func (m *MultiPoint) Equal(g Geometry) bool {
+	return m.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a multiPoint with the same coordinate type and points
+// which coordinates differ no more than tolerance
+func (m *MultiPoint) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*MultiPoint)
+	if !ok || m == nil || other == nil {
+		return ok && m == nil && other == nil
+	}
+
+	if m.IsEmpty() || other.IsEmpty() {
+		return m.IsEmpty() == other.IsEmpty()
+	}
+	return m.Type == other.Type && pointsEqualWithin(m.Points, other.Points, tolerance)
+}
+
+// Bounds returns envelope of multiPoint
+func (m *MultiPoint) Bounds() Envelope {
+	return pointsBounds(m.Points)
 }
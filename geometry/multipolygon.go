@@ -1,10 +1,89 @@
 package geometry
 
+// MultiPolygon is wkt multiPolygon representation
 type MultiPolygon struct {
 	Polygons []*Polygon
 	Type     CoordinateType
 }
 
+// GetGeometryType returns geometry type
 func (m *MultiPolygon) GetGeometryType() Type {
 	return MultiPolygonGT
 }
+
+// CoordType returns coordinate type
+func (m *MultiPolygon) CoordType() CoordinateType {
+	return m.Type
+}
+
+// IsEmpty reports whether multiPolygon has no polygons
+func (m *MultiPolygon) IsEmpty() bool {
+	return m.Type == Empty || len(m.Polygons) == 0
+}
+
+// Dimension returns topological dimension
+func (m *MultiPolygon) Dimension() int {
+	return 2
+}
+
+// NumPoints returns number of points of all polygons
+func (m *MultiPolygon) NumPoints() int {
+	numPoints := 0
+	for _, polygon := range m.Polygons {
+		numPoints += polygon.NumPoints()
+	}
+	return numPoints
+}
+
+// Clone returns deep copy of multiPolygon
+func (m *MultiPolygon) Clone() *MultiPolygon {
+	if m == nil {
+		return nil
+	}
+	clone := &MultiPolygon{Type: m.Type}
+	if m.Polygons != nil {
+		clone.Polygons = make([]*Polygon, 0, len(m.Polygons))
+	}
+	for _, polygon := range m.Polygons {
+		clone.Polygons = append(clone.Polygons, polygon.Clone())
+	}
+	return clone
+}
+
+// Equal reports whether g is a multiPolygon with the same coordinate type and polygons
+func (m *MultiPolygon) Equal(g Geometry) bool {
+	return m.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a multiPolygon with the same coordinate type and polygons
+// which coordinates differ no more than tolerance
+func (m *MultiPolygon) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*MultiPolygon)
+	if !ok || m == nil || other == nil {
+		return ok && m == nil && other == nil
+	}
+
+	if m.IsEmpty() || other.IsEmpty() {
+		return m.IsEmpty() == other.IsEmpty()
+	}
+
+	if m.Type != other.Type || len(m.Polygons) != len(other.Polygons) {
+		return false
+	}
+
+	for i, polygon := range m.Polygons {
+		if !polygon.EqualWithin(other.Polygons[i], tolerance) {
+			return false
+		}
+	}
+	return true
+}
+
+// Bounds returns envelope of multiPolygon
+func (m *MultiPolygon) Bounds() Envelope {
+	e := EmptyEnvelope()
+	for _, polygon := range m.Polygons {
+		e = e.Union(polygon.Bounds())
+	}
+	return e
+}
@@ -4,9 +4,77 @@ package geometry
 type Point struct {
 	X, Y, Z, M float64
 	Type       CoordinateType
+
+	// Text is the original decimal text of coordinates.
+	// It is filled only if parser is asked to keep it, otherwise it is nil.
+	Text *CoordinateText
 }
 
 // GetGeometryType returns geometry type
 func (p *Point) GetGeometryType() Type {
 	return PointGT
 }
+
+// CoordType returns coordinate type
+func (p *Point) CoordType() CoordinateType {
+	return p.Type
+}
+
+// IsEmpty reports whether point is empty
+func (p *Point) IsEmpty() bool {
+	return p.Type == Empty
+}
+
+// Dimension returns topological dimension
+func (p *Point) Dimension() int {
+	return 0
+}
+
+// NumPoints returns number of points
+func (p *Point) NumPoints() int {
+	if p.IsEmpty() {
+		return 0
+	}
+	return 1
+}
+
+// Clone returns deep copy of point
+func (p *Point) Clone() *Point {
+	if p == nil {
+		return nil
+	}
+	clone := *p
+	if p.Text != nil {
+		text := *p.Text
+		clone.Text = &text
+	}
+	return &clone
+}
+
+// Equal reports whether g is a point with the same coordinate type and coordinates
+func (p *Point) Equal(g Geometry) bool {
+	return p.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a point with the same coordinate type and coordinates
+// which differ no more than tolerance
+func (p *Point) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*Point)
+	if !ok || p == nil || other == nil {
+		return ok && p == nil && other == nil
+	}
+
+	if p.IsEmpty() || other.IsEmpty() {
+		return p.IsEmpty() == other.IsEmpty()
+	}
+
+	if p.Type != other.Type || !within(p.X, other.X, tolerance) || !within(p.Y, other.Y, tolerance) {
+		return false
+	}
+	return (!p.Type.HasZ() || within(p.Z, other.Z, tolerance)) && (!p.Type.HasM() || within(p.M, other.M, tolerance))
+}
+
+// Bounds returns envelope of point
+func (p *Point) Bounds() Envelope {
+	return EmptyEnvelope().ExtendToPoint(p)
+}
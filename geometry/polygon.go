@@ -2,11 +2,105 @@ package geometry
 
 // Polygon is wkt polygon representation
 type Polygon struct {
-	LineStrings []*LineString
-	Type        CoordinateType
+	// Rings are the exterior ring followed by interior rings
+	Rings []*LinearRing
+	Type  CoordinateType
 }
 
 // GetGeometryType returns geometry type
 func (p *Polygon) GetGeometryType() Type {
-	return PointGT
+	return PolygonGT
+}
+
+// ExteriorRing returns exterior ring or nil if polygon is empty
+func (p *Polygon) ExteriorRing() *LinearRing {
+	if len(p.Rings) == 0 {
+		return nil
+	}
+	return p.Rings[0]
+}
+
+// InteriorRings returns interior rings (holes)
+func (p *Polygon) InteriorRings() []*LinearRing {
+	if len(p.Rings) == 0 {
+		return nil
+	}
+	return p.Rings[1:]
+}
+
+// CoordType returns coordinate type
+func (p *Polygon) CoordType() CoordinateType {
+	return p.Type
+}
+
+// IsEmpty reports whether polygon has no rings
+func (p *Polygon) IsEmpty() bool {
+	return p.Type == Empty || len(p.Rings) == 0
+}
+
+// Dimension returns topological dimension
+func (p *Polygon) Dimension() int {
+	return 2
+}
+
+// NumPoints returns number of points of all rings
+func (p *Polygon) NumPoints() int {
+	numPoints := 0
+	for _, ring := range p.Rings {
+		numPoints += ring.NumPoints()
+	}
+	return numPoints
+}
+
+// Clone returns deep copy of polygon
+func (p *Polygon) Clone() *Polygon {
+	if p == nil {
+		return nil
+	}
+	clone := &Polygon{Type: p.Type}
+	if p.Rings != nil {
+		clone.Rings = make([]*LinearRing, 0, len(p.Rings))
+	}
+	for _, ring := range p.Rings {
+		clone.Rings = append(clone.Rings, ring.Clone())
+	}
+	return clone
+}
+
+// Equal reports whether g is a polygon with the same coordinate type and rings
+func (p *Polygon) Equal(g Geometry) bool {
+	return p.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a polygon with the same coordinate type and rings
+// which coordinates differ no more than tolerance
+func (p *Polygon) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*Polygon)
+	if !ok || p == nil || other == nil {
+		return ok && p == nil && other == nil
+	}
+
+	if p.IsEmpty() || other.IsEmpty() {
+		return p.IsEmpty() == other.IsEmpty()
+	}
+
+	if p.Type != other.Type || len(p.Rings) != len(other.Rings) {
+		return false
+	}
+
+	for i, ring := range p.Rings {
+		if !ring.EqualWithin(other.Rings[i], tolerance) {
+			return false
+		}
+	}
+	return true
+}
+
+// Bounds returns envelope of polygon
+func (p *Polygon) Bounds() Envelope {
+	e := EmptyEnvelope()
+	for _, ring := range p.Rings {
+		e = e.Union(ring.Bounds())
+	}
+	return e
 }
@@ -0,0 +1,112 @@
+package geometry
+
+// Sequence is a compact sequence of point coordinates stored in a flat slice.
+//
+// Coordinates of i-th point are Coords[i*Stride() : (i+1)*Stride()] in the order of wkt: X Y, X Y Z, X Y M or X Y Z M.
+type Sequence struct {
+	Coords []float64
+	Type   CoordinateType
+}
+
+// NewSequence returns empty sequence of the coordinate type with capacity for the specified number of points
+func NewSequence(ct CoordinateType, capacity int) *Sequence {
+	return &Sequence{Type: ct, Coords: make([]float64, 0, capacity*int(ct.NumCoordinates()))}
+}
+
+// SequenceOf returns sequence with coordinates of the points
+func SequenceOf(ct CoordinateType, points []*Point) *Sequence {
+	s := NewSequence(ct, len(points))
+	for _, point := range points {
+		s.AppendPoint(point)
+	}
+	return s
+}
+
+// Stride returns count of coordinates of a point
+func (s *Sequence) Stride() int {
+	return int(s.Type.NumCoordinates())
+}
+
+// Len returns count of points
+func (s *Sequence) Len() int {
+	stride := s.Stride()
+	if stride == 0 {
+		return 0
+	}
+	return len(s.Coords) / stride
+}
+
+// X returns X of i-th point
+func (s *Sequence) X(i int) float64 {
+	return s.Coords[i*s.Stride()]
+}
+
+// Y returns Y of i-th point
+func (s *Sequence) Y(i int) float64 {
+	return s.Coords[i*s.Stride()+1]
+}
+
+// At returns coordinates of i-th point, absent Z and M are zero
+func (s *Sequence) At(i int) (x, y, z, m float64) {
+	stride := s.Stride()
+	coords := s.Coords[i*stride : (i+1)*stride]
+	x, y = coords[0], coords[1]
+	switch s.Type {
+	case XYZ:
+		z = coords[2]
+	case XYM:
+		m = coords[2]
+	case XYZM:
+		z, m = coords[2], coords[3]
+	}
+	return x, y, z, m
+}
+
+// Set sets coordinates of i-th point, Z and M are ignored if coordinate type hasn't them
+func (s *Sequence) Set(i int, x, y, z, m float64) {
+	stride := s.Stride()
+	coords := s.Coords[i*stride : (i+1)*stride]
+	coords[0], coords[1] = x, y
+	switch s.Type {
+	case XYZ:
+		coords[2] = z
+	case XYM:
+		coords[2] = m
+	case XYZM:
+		coords[2], coords[3] = z, m
+	}
+}
+
+// Append appends point coordinates, Z and M are ignored if coordinate type hasn't them
+func (s *Sequence) Append(x, y, z, m float64) {
+	switch s.Type {
+	case XY:
+		s.Coords = append(s.Coords, x, y)
+	case XYZ:
+		s.Coords = append(s.Coords, x, y, z)
+	case XYM:
+		s.Coords = append(s.Coords, x, y, m)
+	case XYZM:
+		s.Coords = append(s.Coords, x, y, z, m)
+	}
+}
+
+// AppendPoint appends coordinates of the point
+func (s *Sequence) AppendPoint(p *Point) {
+	s.Append(p.X, p.Y, p.Z, p.M)
+}
+
+// Point returns i-th point
+func (s *Sequence) Point(i int) *Point {
+	x, y, z, m := s.At(i)
+	return &Point{X: x, Y: y, Z: z, M: m, Type: s.Type}
+}
+
+// Points returns points of the range [from, to)
+func (s *Sequence) Points(from, to int) []*Point {
+	points := make([]*Point, 0, to-from)
+	for i := from; i < to; i++ {
+		points = append(points, s.Point(i))
+	}
+	return points
+}
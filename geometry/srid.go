@@ -0,0 +1,101 @@
+package geometry
+
+// SRIDer is implemented by geometries with a spatial reference system identifier
+type SRIDer interface {
+	GetSRID() int
+}
+
+// Referenced is a geometry with a spatial reference system identifier, e.g. 4326 for WGS 84
+type Referenced struct {
+	Geometry
+	SRID int
+}
+
+// WithSRID returns geometry referenced to srid
+func WithSRID(g Geometry, srid int) *Referenced {
+	return &Referenced{Geometry: g, SRID: srid}
+}
+
+// GetSRID returns spatial reference system identifier
+func (r *Referenced) GetSRID() int {
+	if r == nil {
+		return 0
+	}
+	return r.SRID
+}
+
+// GetGeometryType returns geometry type, it is UndefinedGT if geometry is nil
+func (r *Referenced) GetGeometryType() Type {
+	if r == nil || r.Geometry == nil {
+		return UndefinedGT
+	}
+	return r.Geometry.GetGeometryType()
+}
+
+// CoordType returns coordinate type, it is Undefined if geometry is nil
+func (r *Referenced) CoordType() CoordinateType {
+	if r == nil || r.Geometry == nil {
+		return Undefined
+	}
+	return r.Geometry.CoordType()
+}
+
+// IsEmpty reports whether geometry is nil or has no points
+func (r *Referenced) IsEmpty() bool {
+	return r == nil || r.Geometry == nil || r.Geometry.IsEmpty()
+}
+
+// Dimension returns topological dimension, it is 0 if geometry is nil
+func (r *Referenced) Dimension() int {
+	if r == nil || r.Geometry == nil {
+		return 0
+	}
+	return r.Geometry.Dimension()
+}
+
+// NumPoints returns number of points, it is 0 if geometry is nil
+func (r *Referenced) NumPoints() int {
+	if r == nil || r.Geometry == nil {
+		return 0
+	}
+	return r.Geometry.NumPoints()
+}
+
+// Bounds returns envelope of geometry, it is empty if geometry is nil
+func (r *Referenced) Bounds() Envelope {
+	if r == nil || r.Geometry == nil {
+		return EmptyEnvelope()
+	}
+	return r.Geometry.Bounds()
+}
+
+// Clone returns deep copy of geometry referenced to the same srid
+func (r *Referenced) Clone() *Referenced {
+	if r == nil {
+		return nil
+	}
+	return &Referenced{Geometry: Clone(r.Geometry), SRID: r.SRID}
+}
+
+// Equal reports whether g is a referenced geometry with the same srid and equal geometry
+func (r *Referenced) Equal(g Geometry) bool {
+	return r.EqualWithin(g, 0)
+}
+
+// EqualWithin reports whether g is a referenced geometry with the same srid and geometry
+// which coordinates differ no more than tolerance
+func (r *Referenced) EqualWithin(g Geometry, tolerance float64) bool {
+	other, ok := g.(*Referenced)
+	if !ok || r == nil || other == nil {
+		return ok && r == nil && other == nil
+	}
+	return r.SRID == other.SRID && EqualWithin(r.Geometry, other.Geometry, tolerance)
+}
+
+// referenceParts references geometries of dumped parts to srid
+func referenceParts(dumped []Dumped, srid int) []Dumped {
+	for i := range dumped {
+		dumped[i].Geometry = WithSRID(dumped[i].Geometry, srid)
+	}
+	return dumped
+}
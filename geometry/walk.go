@@ -0,0 +1,123 @@
+package geometry
+
+import "errors"
+
+// ErrSkipParts is returned by WalkFunc to skip parts of the visited geometry
+var ErrSkipParts = errors.New("skip parts")
+
+// WalkFunc is called by Walk for every geometry and its parts.
+//
+// Path is indexes of the visited geometry in its parents, e.g. [1 0 3] is the fourth point
+// of the first ring of the second polygon of multipolygon. Path is reused between calls,
+// so it must be copied to be retained.
+type WalkFunc func(path []int, g Geometry) error
+
+// Walk visits geometry and all its parts down to points in depth-first order.
+// Referenced geometry is visited as the geometry it wraps, nil geometries are visited without parts.
+//
+// If WalkFunc returns ErrSkipParts, parts of the geometry are not visited.
+// Any other error stops walking and is returned by Walk.
+func Walk(g Geometry, fn WalkFunc) error {
+	err := walk(make([]int, 0, 3), g, fn)
+	if errors.Is(err, ErrSkipParts) {
+		return nil
+	}
+	return err
+}
+
+func walk(path []int, g Geometry, fn WalkFunc) error {
+	if r, ok := g.(*Referenced); ok {
+		if r == nil || r.Geometry == nil {
+			return nil
+		}
+		return walk(path, r.Geometry, fn)
+	}
+
+	if err := fn(path, g); err != nil {
+		return err
+	}
+	if IsNil(g) {
+		return nil
+	}
+
+	var parts []Geometry
+	switch geom := g.(type) {
+	case *MultiPoint:
+		parts = pointParts(geom.Points)
+	case *LineString:
+		parts = pointParts(geom.Points)
+	case *CircularString:
+		parts = pointParts(geom.Points)
+	case *MultiLineString:
+		parts = make([]Geometry, 0, len(geom.Lines))
+		for _, line := range geom.Lines {
+			parts = append(parts, line)
+		}
+	case *Polygon:
+		// rings are visited as line strings sharing the same points
+		parts = make([]Geometry, 0, len(geom.Rings))
+		for _, ring := range geom.Rings {
+			parts = append(parts, ring.LineString())
+		}
+	case *MultiPolygon:
+		parts = make([]Geometry, 0, len(geom.Polygons))
+		for _, polygon := range geom.Polygons {
+			parts = append(parts, polygon)
+		}
+	}
+
+	for i, part := range parts {
+		err := walk(append(path, i), part, fn)
+		if errors.Is(err, ErrSkipParts) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pointParts(points []*Point) []Geometry {
+	parts := make([]Geometry, 0, len(points))
+	for _, point := range points {
+		parts = append(parts, point)
+	}
+	return parts
+}
+
+// TransformFunc returns new coordinates of a point
+type TransformFunc func(x, y, z, m float64) (float64, float64, float64, float64)
+
+// Transform returns copy of geometry with TransformFunc applied to every point.
+// Z and M of coordinate types without them are passed as zero and the returned values are ignored.
+func Transform(g Geometry, fn TransformFunc) Geometry {
+	clone := Clone(g)
+	_ = Walk(clone, func(_ []int, part Geometry) error {
+		point, ok := part.(*Point)
+		if !ok || point.IsEmpty() {
+			return nil
+		}
+
+		var z, m float64
+		if point.Type.HasZ() {
+			z = point.Z
+		}
+		if point.Type.HasM() {
+			m = point.M
+		}
+
+		x, y, z, m := fn(point.X, point.Y, z, m)
+		point.X, point.Y = x, y
+		if point.Type.HasZ() {
+			point.Z = z
+		}
+		if point.Type.HasM() {
+			point.M = m
+		}
+		// the original text doesn't match transformed coordinates
+		point.Text = nil
+		return nil
+	})
+	return clone
+}
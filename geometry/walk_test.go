@@ -0,0 +1,100 @@
+package geometry_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+func testMultiPolygon() *geometry.MultiPolygon {
+	return &geometry.MultiPolygon{
+		Type: geometry.XYM,
+		Polygons: []*geometry.Polygon{
+			{
+				Type: geometry.XYM,
+				Rings: []*geometry.LinearRing{
+					{
+						Type: geometry.XYM,
+						Points: []*geometry.Point{
+							{X: 0, Y: 0, M: 1, Type: geometry.XYM},
+							{X: 1, Y: 0, M: 2, Type: geometry.XYM},
+							{X: 0, Y: 0, M: 3, Type: geometry.XYM},
+						},
+					},
+				},
+			},
+			{
+				Type: geometry.XYM,
+				Rings: []*geometry.LinearRing{
+					{
+						Type: geometry.XYM,
+						Points: []*geometry.Point{
+							{X: 5, Y: 5, M: 4, Type: geometry.XYM},
+							{X: 6, Y: 5, M: 5, Type: geometry.XYM},
+							{X: 5, Y: 5, M: 6, Type: geometry.XYM},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWalk(t *testing.T) {
+	var visited []string
+	err := geometry.Walk(testMultiPolygon(), func(path []int, g geometry.Geometry) error {
+		visited = append(visited, fmt.Sprintf("%s %v", g.GetGeometryType(), path))
+		if len(path) == 1 && path[0] == 1 {
+			return geometry.ErrSkipParts
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	expected := []string{
+		"MULTIPOLYGON []",
+		"POLYGON [0]",
+		"LINESTRING [0 0]",
+		"POINT [0 0 0]",
+		"POINT [0 0 1]",
+		"POINT [0 0 2]",
+		"POLYGON [1]",
+	}
+	if diff := cmp.Diff(visited, expected); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	original := testMultiPolygon()
+	transformed := geometry.Transform(original, func(x, y, z, m float64) (float64, float64, float64, float64) {
+		return x + 10, y * 2, z + 100, m * 10
+	})
+
+	expected := testMultiPolygon()
+	for _, polygon := range expected.Polygons {
+		for _, point := range polygon.Rings[0].Points {
+			point.X, point.Y, point.M = point.X+10, point.Y*2, point.M*10
+		}
+	}
+
+	if diff := cmp.Diff(transformed, expected); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if diff := cmp.Diff(original, testMultiPolygon()); diff != "" {
+		t.Fatal("\noriginal is changed\n-want +got\n", diff)
+	}
+
+	referenced := geometry.Transform(geometry.WithSRID(original, 4326), func(x, y, z, m float64) (float64, float64, float64, float64) {
+		return x + 10, y * 2, z + 100, m * 10
+	})
+	if diff := cmp.Diff(referenced, geometry.Geometry(geometry.WithSRID(expected, 4326))); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"text/scanner"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+// ParseFunc parses geometry of a custom keyword.
+//
+// It is called after the coordinate type is detected, so the opening parenthesis is already consumed
+// and ParseFunc must consume the closing one. For "KEYWORD EMPTY" it is called with geometry.Empty.
+type ParseFunc func(p *Parser, ct geometry.CoordinateType) (geometry.Geometry, error)
+
+// Register registers ParseFunc for the custom geometry keyword such as BOX or CIRCLE.
+// Keywords of supported geometry types can't be overridden.
+func (p *Parser) Register(keyword text.Token, fn ParseFunc) {
+	if p.custom == nil {
+		p.custom = make(map[text.Token]ParseFunc)
+	}
+	p.custom[keyword] = fn
+}
+
+// lookupCustom returns ParseFunc registered for the keyword
+func (p *Parser) lookupCustom(keyword text.Token) (ParseFunc, bool) {
+	if fn, ok := p.custom[keyword]; ok {
+		return fn, true
+	}
+
+	if p.dialect.CaseInsensitive {
+		for k, fn := range p.custom {
+			if strings.EqualFold(string(k), string(keyword)) {
+				return fn, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (p *Parser) parseCustom(fn ParseFunc) (geometry.Geometry, error) {
+	ct, err := p.detectCoordType()
+	if err != nil {
+		return nil, fmt.Errorf("detect coordinate type: %w", err)
+	}
+	// custom geometries define their coordinates themselves
+	p.inferDimension = false
+
+	geom, err := fn(p, ct)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", p.customKeyword, err)
+	}
+	return geom, nil
+}
+
+// ParsePoint parses coordinates of a point of the specified coordinate type.
+// It is intended to be used by ParseFunc.
+func (p *Parser) ParsePoint(ct geometry.CoordinateType) (*geometry.Point, error) {
+	return p.parsePoint(ct)
+}
+
+// ParsePoints parses comma separated points up to the closing parenthesis.
+// It is intended to be used by ParseFunc.
+func (p *Parser) ParsePoints(ct geometry.CoordinateType) ([]*geometry.Point, error) {
+	lineString, err := p.parseLineString(ct)
+	if err != nil {
+		return nil, err
+	}
+	return lineString.Points, nil
+}
+
+// ParseNumber parses a single number.
+// It is intended to be used by ParseFunc.
+func (p *Parser) ParseNumber() (float64, error) {
+	if p.scanner.Scan() == scanner.EOF {
+		return 0, ErrUnexpectedEOF
+	}
+
+	c, _, err := parseCoord(p.scanner)
+	return c, err
+}
+
+// NextToken scans and returns the next token.
+// It is intended to be used by ParseFunc.
+func (p *Parser) NextToken() (text.Token, error) {
+	if p.scanner.Scan() == scanner.EOF {
+		return "", ErrUnexpectedEOF
+	}
+	return p.keyword(), nil
+}
+
+// ExpectToken scans the next token and checks that it is equal to the specified token.
+// It is intended to be used by ParseFunc.
+func (p *Parser) ExpectToken(token text.Token) error {
+	return p.skipTokenAndCheck(token)
+}
@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"text/scanner"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+// keyword returns current token normalized according to the dialect
+func (p *Parser) keyword() text.Token {
+	if p.dialect.CaseInsensitive {
+		return text.Token(strings.ToUpper(p.scanner.TokenText()))
+	}
+	return text.Token(p.scanner.TokenText())
+}
+
+// splitAttachedDimension splits keyword such as POINTZM to keyword and coordinate type
+func splitAttachedDimension(keyword text.Token) (text.Token, geometry.CoordinateType) {
+	suffixes := []struct {
+		tag text.Token
+		ct  geometry.CoordinateType
+	}{
+		{tag: text.ZMCoordinates, ct: geometry.XYZM},
+		{tag: text.ZCoordinates, ct: geometry.XYZ},
+		{tag: text.MCoordinates, ct: geometry.XYM},
+	}
+
+	for _, suffix := range suffixes {
+		trimmed := strings.TrimSuffix(string(keyword), string(suffix.tag))
+		if trimmed != string(keyword) && text.IsKeyword(text.Token(trimmed)) {
+			return text.Token(trimmed), suffix.ct
+		}
+	}
+	return keyword, geometry.Undefined
+}
+
+// peekRune skips whitespaces and returns next rune without consuming it
+func (p *Parser) peekRune() rune {
+	for {
+		ch := p.scanner.Peek()
+		if ch == scanner.EOF || ch < 0 || p.scanner.Whitespace&(1<<uint(ch)) == 0 {
+			return ch
+		}
+		p.scanner.Next()
+	}
+}
+
+// parseInferredPoint parses point of geometry without dimension tag.
+// Coordinate type is taken from the number of coordinates and must be the same for all points.
+func (p *Parser) parseInferredPoint() (*geometry.Point, error) {
+	var coords [geometry.NumXYZM]float64
+	var literals [geometry.NumXYZM]string
+	ct, err := p.scanInferredCoords(&coords, &literals)
+	if err != nil {
+		return nil, err
+	}
+	return p.newPoint(ct, coords[:], literals[:]), nil
+}
+
+// scanInferredCoords scans coordinates of point without dimension tag to coords in the order of wkt
+// and returns coordinate type inferred from the number of coordinates.
+// NULL is accepted in place of Z and M if the dialect allows null coordinates, e.g. "1 2 NULL 4" is XYM.
+func (p *Parser) scanInferredCoords(
+	coords *[geometry.NumXYZM]float64, literals *[geometry.NumXYZM]string,
+) (geometry.CoordinateType, error) {
+	var nulls [geometry.NumXYZM]bool
+	n := 0
+	for {
+		if p.scanner.Scan() == scanner.EOF {
+			return geometry.Undefined, ErrUnexpectedEOF
+		}
+
+		if n == int(geometry.NumXYZM) {
+			return geometry.Undefined, fmt.Errorf("%w: %s", ErrUnexpectedToken, p.scanner.TokenText())
+		}
+
+		if p.dialect.NullCoordinates && n >= int(geometry.NumXY) && p.keyword() == text.Null {
+			nulls[n], coords[n], literals[n] = true, 0, ""
+		} else {
+			c, literal, err := parseCoord(p.scanner)
+			if err != nil {
+				return geometry.Undefined, fmt.Errorf("parseCoord: %w", err)
+			}
+			coords[n], literals[n] = c, literal
+		}
+		n++
+
+		if next := p.peekRune(); next == ',' || next == ')' || next == scanner.EOF {
+			break
+		}
+	}
+
+	var ct geometry.CoordinateType
+	switch {
+	case n == int(geometry.NumXY):
+		ct = geometry.XY
+	case n == int(geometry.NumXYZ) && nulls[2]:
+		ct = geometry.XY
+	case n == int(geometry.NumXYZ):
+		ct = geometry.XYZ
+	case nulls[2] && nulls[3]:
+		ct = geometry.XY
+	case nulls[2]:
+		ct = geometry.XYM
+		coords[2], literals[2] = coords[3], literals[3]
+	case nulls[3]:
+		ct = geometry.XYZ
+	default:
+		ct = geometry.XYZM
+	}
+
+	if p.inferredCT == geometry.Undefined {
+		p.inferredCT = ct
+	} else if p.inferredCT != ct {
+		return geometry.Undefined, fmt.Errorf("%w: %d", ErrUnexpectedCoordinateType, ct)
+	}
+	return ct, nil
+}
+
+// setCoordinateType sets coordinate type of geometry and all its parts
+func setCoordinateType(g geometry.Geometry, ct geometry.CoordinateType) {
+	switch geom := g.(type) {
+	case *geometry.Point:
+		geom.Type = ct
+	case *geometry.MultiPoint:
+		geom.Type = ct
+		for _, point := range geom.Points {
+			setCoordinateType(point, ct)
+		}
+	case *geometry.LineString:
+		geom.Type = ct
+		for _, point := range geom.Points {
+			setCoordinateType(point, ct)
+		}
+	case *geometry.CircularString:
+		geom.Type = ct
+		for _, point := range geom.Points {
+			setCoordinateType(point, ct)
+		}
+	case *geometry.MultiLineString:
+		geom.Type = ct
+		for _, line := range geom.Lines {
+			setCoordinateType(line, ct)
+		}
+	case *geometry.Polygon:
+		geom.Type = ct
+		for _, ring := range geom.Rings {
+			setCoordinateType(ring.LineString(), ct)
+		}
+	case *geometry.MultiPolygon:
+		geom.Type = ct
+		for _, polygon := range geom.Polygons {
+			setCoordinateType(polygon, ct)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"text/scanner"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+// ParseFlat detects a geometry object and returns its compact representation.
+//
+// Unlike ParseWKT it doesn't allocate a Point for every coordinate, so it is preferable for large inputs.
+// Custom geometry keywords are not supported.
+func (p *Parser) ParseFlat(r io.Reader) (*geometry.Flat, error) {
+	p.reset(r)
+
+	gt, err := p.detectGeomType()
+	if err != nil {
+		return nil, fmt.Errorf("detect geometry type: %w", err)
+	}
+
+	if gt == geometry.UndefinedGT {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedGeometryType, p.customKeyword)
+	}
+
+	ct, err := p.detectCoordType()
+	if err != nil {
+		return nil, fmt.Errorf("detect coordinate type: %w", err)
+	}
+
+	flat := &geometry.Flat{GeomType: gt, Coords: geometry.Sequence{Type: ct}}
+	if ct == geometry.Empty {
+		return flat, nil
+	}
+
+	switch gt {
+	case geometry.PointGT:
+		if err := p.appendFlatPoint(&flat.Coords, ct); err != nil {
+			return nil, fmt.Errorf("parse point: %w", err)
+		}
+
+		if err := p.skipClosingParenthesis(); err != nil {
+			return nil, fmt.Errorf("skip closing parenthesis: %w", err)
+		}
+
+	case geometry.MultiPointGT, geometry.LineStringGT, geometry.CircularStringGT:
+		if err := p.appendFlatPoints(&flat.Coords, ct, gt == geometry.MultiPointGT); err != nil {
+			return nil, fmt.Errorf("parse points: %w", err)
+		}
+
+	case geometry.MultiLineStringGT, geometry.PolygonGT:
+		if flat.Ends, err = p.appendFlatLines(&flat.Coords, ct, gt == geometry.PolygonGT); err != nil {
+			return nil, fmt.Errorf("parse lines: %w", err)
+		}
+
+	case geometry.MultiPolygonGT:
+		if flat.Endss, err = p.appendFlatPolygons(&flat.Coords, ct); err != nil {
+			return nil, fmt.Errorf("parse polygons: %w", err)
+		}
+	}
+
+	return flat, nil
+}
+
+// appendFlatPoint parses coordinates of a point and appends them to the sequence
+func (p *Parser) appendFlatPoint(seq *geometry.Sequence, ct geometry.CoordinateType) error {
+	if ct == geometry.XY && p.inferDimension {
+		var coords [geometry.NumXYZM]float64
+		var literals [geometry.NumXYZM]string
+		inferred, err := p.scanInferredCoords(&coords, &literals)
+		if err != nil {
+			return fmt.Errorf("scanInferredCoords: %w", err)
+		}
+
+		seq.Type = inferred
+		seq.Coords = append(seq.Coords, coords[:inferred.NumCoordinates()]...)
+		return nil
+	}
+
+	for i := 0; i < int(ct.NumCoordinates()); i++ {
+		if p.scanner.Scan() == scanner.EOF {
+			return ErrUnexpectedEOF
+		}
+
+		c, _, err := parseCoord(p.scanner)
+		if err != nil {
+			return fmt.Errorf("parseCoord: %w", err)
+		}
+		seq.Coords = append(seq.Coords, c)
+	}
+	return nil
+}
+
+// appendFlatPoints parses comma separated points up to the closing parenthesis
+func (p *Parser) appendFlatPoints(seq *geometry.Sequence, ct geometry.CoordinateType, multiPoint bool) error {
+	for {
+		parenthesized := multiPoint && p.dialect.ParenthesizedMultiPoint && p.peekRune() == '('
+		if parenthesized {
+			if err := p.skipTokenAndCheck(text.OpeningParenthesis); err != nil {
+				return fmt.Errorf("skipTokenAndCheck: %w", err)
+			}
+		}
+
+		if err := p.appendFlatPoint(seq, ct); err != nil {
+			return fmt.Errorf("appendFlatPoint: %w", err)
+		}
+
+		if parenthesized {
+			if err := p.skipTokenAndCheck(text.ClosingParenthesis); err != nil {
+				return fmt.Errorf("skipTokenAndCheck: %w", err)
+			}
+		}
+
+		separator, err := p.scanSeparator()
+		if err != nil {
+			return fmt.Errorf("scanSeparator: %w", err)
+		}
+
+		switch separator {
+		case text.ClosingParenthesis:
+			return nil
+		case text.Comma:
+			continue
+		default:
+			return fmt.Errorf("%w: %s", ErrUnexpectedToken, p.scanner.TokenText())
+		}
+	}
+}
+
+// appendFlatLines parses comma separated lines up to the closing parenthesis and returns their ends
+func (p *Parser) appendFlatLines(seq *geometry.Sequence, ct geometry.CoordinateType, rings bool) ([]int, error) {
+	var ends []int
+	for {
+		if err := p.skipTokenAndCheck(text.OpeningParenthesis); err != nil {
+			return nil, fmt.Errorf("skipTokenAndCheck: %w", err)
+		}
+
+		start := seq.Len()
+		if err := p.appendFlatPoints(seq, ct, false); err != nil {
+			return nil, fmt.Errorf("appendFlatPoints: %w", err)
+		}
+
+		if rings {
+			p.closeFlatRing(seq, start)
+		}
+		ends = append(ends, seq.Len())
+
+		separator, err := p.scanSeparator()
+		if err != nil {
+			return nil, fmt.Errorf("scanSeparator: %w", err)
+		}
+
+		switch separator {
+		case text.ClosingParenthesis:
+			return ends, nil
+		case text.Comma:
+			continue
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnexpectedToken, p.scanner.TokenText())
+		}
+	}
+}
+
+// appendFlatPolygons parses comma separated polygons up to the closing parenthesis and returns their ends
+func (p *Parser) appendFlatPolygons(seq *geometry.Sequence, ct geometry.CoordinateType) ([][]int, error) {
+	var endss [][]int
+	for {
+		if err := p.skipTokenAndCheck(text.OpeningParenthesis); err != nil {
+			return nil, fmt.Errorf("skipTokenAndCheck: %w", err)
+		}
+
+		ends, err := p.appendFlatLines(seq, ct, true)
+		if err != nil {
+			return nil, fmt.Errorf("appendFlatLines: %w", err)
+		}
+		endss = append(endss, ends)
+
+		separator, err := p.scanSeparator()
+		if err != nil {
+			return nil, fmt.Errorf("scanSeparator: %w", err)
+		}
+
+		switch separator {
+		case text.ClosingParenthesis:
+			return endss, nil
+		case text.Comma:
+			continue
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnexpectedToken, p.scanner.TokenText())
+		}
+	}
+}
+
+// closeFlatRing appends the first point to the ring starting at start if it is not closed in repair mode
+func (p *Parser) closeFlatRing(seq *geometry.Sequence, start int) {
+	last := seq.Len() - 1
+	if !p.repair || last < start {
+		return
+	}
+
+	x1, y1, z1, m1 := seq.At(start)
+	x2, y2, z2, m2 := seq.At(last)
+	if x1 == x2 && y1 == y2 && z1 == z2 && m1 == m2 {
+		return
+	}
+
+	seq.Append(x1, y1, z1, m1)
+	p.addRepair(ClosedRing, p.scanner.Position.Offset)
+}
@@ -2,7 +2,6 @@ package parser
 
 import (
 	"fmt"
-	"text/scanner"
 
 	"github.com/IvanZagoskin/wkt/geometry"
 	"github.com/IvanZagoskin/wkt/text"
@@ -19,11 +18,12 @@ func (p *Parser) parseLineString(ct geometry.CoordinateType) (*geometry.LineStri
 			}
 			lineString.Points = append(lineString.Points, point)
 
-			if p.scanner.Scan() == scanner.EOF {
-				return nil, ErrUnexpectedEOF
+			separator, err := p.scanSeparator()
+			if err != nil {
+				return nil, fmt.Errorf("scanSeparator: %w", err)
 			}
 
-			switch text.Token(p.scanner.TokenText()) {
+			switch separator {
 			case text.ClosingParenthesis:
 				return lineString, nil
 			case text.Comma:
@@ -2,7 +2,6 @@ package parser
 
 import (
 	"fmt"
-	"text/scanner"
 
 	"github.com/IvanZagoskin/wkt/geometry"
 	"github.com/IvanZagoskin/wkt/text"
@@ -13,17 +12,18 @@ func (p *Parser) parseMultiPoint(ct geometry.CoordinateType) (*geometry.MultiPoi
 	case geometry.XY, geometry.XYM, geometry.XYZ, geometry.XYZM:
 		multiPoint := &geometry.MultiPoint{Type: ct}
 		for {
-			point, err := p.parsePoint(ct)
+			point, err := p.parseMultiPointMember(ct)
 			if err != nil {
-				return nil, fmt.Errorf("parseLineString: %w", err)
+				return nil, fmt.Errorf("parseMultiPointMember: %w", err)
 			}
 			multiPoint.Points = append(multiPoint.Points, point)
 
-			if p.scanner.Scan() == scanner.EOF {
-				return nil, ErrUnexpectedEOF
+			separator, err := p.scanSeparator()
+			if err != nil {
+				return nil, fmt.Errorf("scanSeparator: %w", err)
 			}
 
-			switch text.Token(p.scanner.TokenText()) {
+			switch separator {
 			case text.ClosingParenthesis:
 				return multiPoint, nil
 			case text.Comma:
@@ -37,3 +37,24 @@ func (p *Parser) parseMultiPoint(ct geometry.CoordinateType) (*geometry.MultiPoi
 		return nil, fmt.Errorf("%w: %d", ErrUnexpectedCoordinateType, ct)
 	}
 }
+
+// parseMultiPointMember parses point of multipoint which may be in parentheses if dialect allows it
+func (p *Parser) parseMultiPointMember(ct geometry.CoordinateType) (*geometry.Point, error) {
+	if !p.dialect.ParenthesizedMultiPoint || p.peekRune() != '(' {
+		return p.parsePoint(ct)
+	}
+
+	if err := p.skipTokenAndCheck(text.OpeningParenthesis); err != nil {
+		return nil, fmt.Errorf("skipTokenAndCheck: %w", err)
+	}
+
+	point, err := p.parsePoint(ct)
+	if err != nil {
+		return nil, fmt.Errorf("parsePoint: %w", err)
+	}
+
+	if err := p.skipClosingParenthesis(); err != nil {
+		return nil, fmt.Errorf("skipClosingParenthesis: %w", err)
+	}
+	return point, nil
+}
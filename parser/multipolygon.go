@@ -2,7 +2,6 @@ package parser
 
 import (
 	"fmt"
-	"text/scanner"
 
 	"github.com/IvanZagoskin/wkt/geometry"
 	"github.com/IvanZagoskin/wkt/text"
@@ -24,11 +23,12 @@ func (p *Parser) parseMultiPolygon(ct geometry.CoordinateType) (*geometry.MultiP
 			}
 			multyPolygon.Polygons = append(multyPolygon.Polygons, polygon)
 
-			if p.scanner.Scan() == scanner.EOF {
-				return nil, ErrUnexpectedEOF
+			separator, err := p.scanSeparator()
+			if err != nil {
+				return nil, fmt.Errorf("scanSeparator: %w", err)
 			}
 
-			switch text.Token(p.scanner.TokenText()) {
+			switch separator {
 			case text.ClosingParenthesis:
 				return multyPolygon, nil
 			case text.Comma:
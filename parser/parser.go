@@ -17,28 +17,92 @@ var (
 	ErrUnexpectedEOF            = errors.New("unexpected EOF")
 	ErrUnexpectedGeometryType   = errors.New("unexpected geometry type")
 	ErrUnexpectedCoordinateType = errors.New("unexpected coordinate type")
+	ErrUnsupportedGeometryType  = errors.New("unsupported geometry type")
 )
 
 // Parser implements parsing wkt
 type Parser struct {
 	scanner *scanner.Scanner
+
+	keepCoordinateText bool
+	dialect            text.Dialect
+
+	// attachedCT is a coordinate type attached to the geometry keyword, e.g. POINTM
+	attachedCT geometry.CoordinateType
+	// inferDimension is set when coordinate type must be inferred from the first point
+	inferDimension bool
+	inferredCT     geometry.CoordinateType
+
+	custom        map[text.Token]ParseFunc
+	customKeyword text.Token
+
+	repair  bool
+	repairs []Repair
+}
+
+// Option configures Parser
+type Option func(p *Parser)
+
+// KeepCoordinateText makes Parser keep the original decimal text of every coordinate
+// in geometry.Point.Text, so it can be written back without loss of precision
+func KeepCoordinateText() Option {
+	return func(p *Parser) {
+		p.keepCoordinateText = true
+	}
+}
+
+// WithDialect makes Parser accept WKT of the specified dialect
+func WithDialect(d text.Dialect) Option {
+	return func(p *Parser) {
+		p.dialect = d
+	}
 }
 
 // New returns Parser
-func New() *Parser {
-	return &Parser{scanner: &scanner.Scanner{}}
+func New(opts ...Option) *Parser {
+	p := &Parser{scanner: &scanner.Scanner{}}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // ParseWKT detects a geometry object and returns it
 func (p *Parser) ParseWKT(r io.Reader) (geometry.Geometry, error) {
+	p.reset(r)
+
+	geom, err := p.parseGeometry()
+	if err != nil {
+		return nil, err
+	}
+
+	// geometries without dimension tag are parsed as XY until the first point is read
+	if p.inferredCT != geometry.Undefined && p.inferredCT != geometry.XY {
+		setCoordinateType(geom, p.inferredCT)
+	}
+
+	return geom, nil
+}
+
+// reset prepares Parser to parse the next input
+func (p *Parser) reset(r io.Reader) {
 	p.scanner.Init(r)
+	p.attachedCT, p.inferDimension, p.inferredCT = geometry.Undefined, false, geometry.Undefined
+	p.customKeyword = ""
+	p.repairs = nil
+}
 
+func (p *Parser) parseGeometry() (geometry.Geometry, error) {
 	gt, err := p.detectGeomType()
 	if err != nil {
 		return nil, fmt.Errorf("detect geometry type: %w", err)
 	}
 
 	switch gt {
+	case geometry.UndefinedGT:
+		fn, _ := p.lookupCustom(p.customKeyword)
+		return p.parseCustom(fn)
+
 	case geometry.PointGT:
 		ct, err := p.detectCoordType()
 		if err != nil {
@@ -54,20 +118,20 @@ func (p *Parser) ParseWKT(r io.Reader) (geometry.Geometry, error) {
 			return nil, fmt.Errorf("parse point: %w", err)
 		}
 
-		if err := p.skipTokenAndCheck(text.ClosingParenthesis); err != nil {
-			return nil, fmt.Errorf("skip token and check: %w", err)
+		if err := p.skipClosingParenthesis(); err != nil {
+			return nil, fmt.Errorf("skip closing parenthesis: %w", err)
 		}
 
 		return point, nil
 
-	case geometry.MultyPointGT:
+	case geometry.MultiPointGT:
 		ct, err := p.detectCoordType()
 		if err != nil {
 			return nil, fmt.Errorf("detect coordinate type: %w", err)
 		}
 
 		if ct == geometry.Empty {
-			return &geometry.Point{Type: geometry.Empty}, nil
+			return &geometry.MultiPoint{Type: geometry.Empty}, nil
 		}
 
 		multiPoint, err := p.parseMultiPoint(ct)
@@ -84,7 +148,7 @@ func (p *Parser) ParseWKT(r io.Reader) (geometry.Geometry, error) {
 		}
 
 		if ct == geometry.Empty {
-			return &geometry.Point{Type: geometry.Empty}, nil
+			return &geometry.LineString{Type: geometry.Empty}, nil
 		}
 
 		lineString, err := p.parseLineString(ct)
@@ -101,7 +165,7 @@ func (p *Parser) ParseWKT(r io.Reader) (geometry.Geometry, error) {
 		}
 
 		if ct == geometry.Empty {
-			return &geometry.Point{Type: geometry.Empty}, nil
+			return &geometry.CircularString{Type: geometry.Empty}, nil
 		}
 
 		circularString, err := p.parseCircularString(ct)
@@ -118,7 +182,7 @@ func (p *Parser) ParseWKT(r io.Reader) (geometry.Geometry, error) {
 		}
 
 		if ct == geometry.Empty {
-			return &geometry.Point{Type: geometry.Empty}, nil
+			return &geometry.MultiLineString{Type: geometry.Empty}, nil
 		}
 
 		multiLineString, err := p.parseMultiLineString(ct)
@@ -135,7 +199,7 @@ func (p *Parser) ParseWKT(r io.Reader) (geometry.Geometry, error) {
 		}
 
 		if ct == geometry.Empty {
-			return &geometry.Point{Type: geometry.Empty}, nil
+			return &geometry.Polygon{Type: geometry.Empty}, nil
 		}
 
 		polygon, err := p.parsePolygon(ct)
@@ -152,7 +216,7 @@ func (p *Parser) ParseWKT(r io.Reader) (geometry.Geometry, error) {
 		}
 
 		if ct == geometry.Empty {
-			return &geometry.Point{Type: geometry.Empty}, nil
+			return &geometry.MultiPolygon{Type: geometry.Empty}, nil
 		}
 
 		multiPolygon, err := p.parseMultiPolygon(ct)
@@ -172,31 +236,34 @@ func (p *Parser) detectGeomType() (geometry.Type, error) {
 		return geometry.UndefinedGT, ErrUnexpectedEOF
 	}
 
-	switch text.Token(p.scanner.TokenText()) {
-	case text.POINT:
-		return geometry.PointGT, nil
-
-	case text.MULTIPOINT:
-		return geometry.MultyPointGT, nil
-
-	case text.LINESTRING:
-		return geometry.LineStringGT, nil
-
-	case text.CIRCULARSTRING:
-		return geometry.CircularStringGT, nil
-
-	case text.MULTILINESTRING:
-		return geometry.MultiLineStringGT, nil
-
-	case text.POLYGON:
-		return geometry.PolygonGT, nil
+	keyword := p.keyword()
+	if p.dialect.AttachedDimension {
+		keyword, p.attachedCT = splitAttachedDimension(keyword)
+	}
+	if alias, ok := p.dialect.Aliases[keyword]; ok {
+		keyword = alias
+	}
 
-	case text.MULTIPOLYGON:
-		return geometry.MultiPolygonGT, nil
+	if !text.IsKeyword(keyword) {
+		if _, ok := p.lookupCustom(keyword); ok {
+			p.customKeyword = keyword
+			return geometry.UndefinedGT, nil
+		}
 
-	default:
+		// vendor keywords are valid wkt, but there is no geometry to parse them to
+		for _, extension := range p.dialect.Extensions {
+			if keyword == extension {
+				return geometry.UndefinedGT, fmt.Errorf("%w: %s", ErrUnsupportedGeometryType, p.scanner.TokenText())
+			}
+		}
 		return geometry.UndefinedGT, fmt.Errorf("%w: %s", ErrUnexpectedGeometryType, p.scanner.TokenText())
 	}
+
+	gt, err := geometry.ParseType(string(keyword))
+	if err != nil || gt == geometry.GeometryCollectionGT {
+		return geometry.UndefinedGT, fmt.Errorf("%w: %s", ErrUnsupportedGeometryType, p.scanner.TokenText())
+	}
+	return gt, nil
 }
 
 func (p *Parser) detectCoordType() (geometry.CoordinateType, error) {
@@ -204,7 +271,19 @@ func (p *Parser) detectCoordType() (geometry.CoordinateType, error) {
 		return geometry.Undefined, ErrUnexpectedEOF
 	}
 
-	switch text.Token(p.scanner.TokenText()) {
+	keyword := p.keyword()
+	if p.attachedCT != geometry.Undefined {
+		switch keyword {
+		case text.OpeningParenthesis:
+			return p.attachedCT, nil
+		case text.Empty:
+			return geometry.Empty, nil
+		default:
+			return geometry.Undefined, fmt.Errorf("%w: %s", ErrUnexpectedCoordinateType, p.scanner.TokenText())
+		}
+	}
+
+	switch keyword {
 	case text.ZCoordinates:
 		if err := p.skipTokenAndCheck(text.OpeningParenthesis); err != nil {
 			return geometry.Undefined, fmt.Errorf("skip token and check: %w", err)
@@ -224,6 +303,7 @@ func (p *Parser) detectCoordType() (geometry.CoordinateType, error) {
 		return geometry.XYZM, nil
 
 	case text.OpeningParenthesis:
+		p.inferDimension = p.dialect.ImplicitDimension
 		return geometry.XY, nil
 
 	case text.Empty:
@@ -246,53 +326,52 @@ func (p *Parser) skipTokenAndCheck(token text.Token) error {
 	return nil
 }
 
-// countCoordinatesBy returns count expected coordinates for current coordinate type
-func countCoordinatesBy(ct geometry.CoordinateType) geometry.NumberOfCoordinates {
-	switch ct {
-	case geometry.XY:
-		return geometry.NumXY
-	case geometry.XYM:
-		return geometry.NumXYM
-	case geometry.XYZ:
-		return geometry.NumXYZ
-	case geometry.XYZM:
-		return geometry.NumXYZM
-	default:
-		return geometry.NumUndefined
-	}
-}
-
-// parsePointCoords parse coordinates and returns slice with them.
+// parsePointCoords parse coordinates and returns slice with them
+// and slice with their original decimal text.
 //
 // Must be called only if you sure that next tokens are coordinates.
-func parsePointCoords(s *scanner.Scanner, ct geometry.CoordinateType) ([]float64, error) {
-	countCoordinates := countCoordinatesBy(ct)
+func parsePointCoords(s *scanner.Scanner, ct geometry.CoordinateType) ([]float64, []string, error) {
+	countCoordinates := ct.NumCoordinates()
 	coordinates := make([]float64, 0, countCoordinates)
+	literals := make([]string, 0, countCoordinates)
 	for tok := s.Scan(); ; tok = s.Scan() {
 		if tok == scanner.EOF {
-			return nil, ErrUnexpectedEOF
+			return nil, nil, ErrUnexpectedEOF
 		}
 
-		isNegative := false
-		if strings.EqualFold(s.TokenText(), string(text.Minus)) {
-			isNegative = true
-			if s.Scan() == scanner.EOF {
-				return nil, ErrUnexpectedEOF
-			}
-		}
-
-		c, err := strconv.ParseFloat(s.TokenText(), 64)
+		c, literal, err := parseCoord(s)
 		if err != nil {
-			return nil, err
-		}
-
-		if isNegative {
-			c = -c
+			return nil, nil, err
 		}
 
 		coordinates = append(coordinates, c)
+		literals = append(literals, literal)
 		if len(coordinates) == int(countCoordinates) {
-			return coordinates, nil
+			return coordinates, literals, nil
 		}
 	}
 }
+
+// parseCoord parses coordinate starting from the current token and returns it with its original text
+func parseCoord(s *scanner.Scanner) (float64, string, error) {
+	isNegative := false
+	if strings.EqualFold(s.TokenText(), string(text.Minus)) {
+		isNegative = true
+		if s.Scan() == scanner.EOF {
+			return 0, "", ErrUnexpectedEOF
+		}
+	}
+
+	literal := s.TokenText()
+	c, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if isNegative {
+		c = -c
+		literal = string(text.Minus) + literal
+	}
+
+	return c, literal, nil
+}
@@ -7,9 +7,11 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/IvanZagoskin/wkt/geometry"
 	"github.com/IvanZagoskin/wkt/parser"
+	"github.com/IvanZagoskin/wkt/text"
 )
 
 func TestWktParser_Point(t *testing.T) {
@@ -93,8 +95,9 @@ func TestWktParser_Point(t *testing.T) {
 				return
 			}
 
+			// compare values, Equal method of *geometry.Point ignores coordinate text
 			point := geom.(*geometry.Point)
-			if diff := cmp.Diff(point, tc.Expected); diff != "" {
+			if diff := cmp.Diff(*point, *tc.Expected); diff != "" {
 				t.Fatal("\n-want +got\n", diff)
 			}
 		})
@@ -513,7 +516,7 @@ func TestWktParser_Polygon(t *testing.T) {
 			Wkt:  []byte("POLYGON ((30 10, 40 40, 20 40, 10 20, 30 10))"),
 			Expected: &geometry.Polygon{
 				Type: geometry.XY,
-				LineStrings: []*geometry.LineString{
+				Rings: []*geometry.LinearRing{
 					{
 						Type: geometry.XY,
 						Points: []*geometry.Point{
@@ -532,7 +535,7 @@ func TestWktParser_Polygon(t *testing.T) {
 			Wkt:  []byte("POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))"),
 			Expected: &geometry.Polygon{
 				Type: geometry.XY,
-				LineStrings: []*geometry.LineString{
+				Rings: []*geometry.LinearRing{
 					{
 						Type: geometry.XY,
 						Points: []*geometry.Point{
@@ -560,7 +563,7 @@ func TestWktParser_Polygon(t *testing.T) {
 			Wkt:  []byte("POLYGON Z((30 10 10, 40 40 20, 20 40 30, 10 20 40, 30 10 50))"),
 			Expected: &geometry.Polygon{
 				Type: geometry.XYZ,
-				LineStrings: []*geometry.LineString{
+				Rings: []*geometry.LinearRing{
 					{
 						Type: geometry.XYZ,
 						Points: []*geometry.Point{
@@ -579,7 +582,7 @@ func TestWktParser_Polygon(t *testing.T) {
 			Wkt:  []byte("POLYGON M((30 10 10, 40 40 20, 20 40 30, 10 20 40, 30 10 50))"),
 			Expected: &geometry.Polygon{
 				Type: geometry.XYM,
-				LineStrings: []*geometry.LineString{
+				Rings: []*geometry.LinearRing{
 					{
 						Type: geometry.XYM,
 						Points: []*geometry.Point{
@@ -598,7 +601,7 @@ func TestWktParser_Polygon(t *testing.T) {
 			Wkt:  []byte("POLYGON ZM((30 10 10 -10.10, 40 40 20 -20.20, 20 40 30 -30.30, 10 20 40 -40.40, 30 10 50 -50.50))"),
 			Expected: &geometry.Polygon{
 				Type: geometry.XYZM,
-				LineStrings: []*geometry.LineString{
+				Rings: []*geometry.LinearRing{
 					{
 						Type: geometry.XYZM,
 						Points: []*geometry.Point{
@@ -663,7 +666,7 @@ MULTIPOLYGON
 				Polygons: []*geometry.Polygon{
 					{
 						Type: geometry.XY,
-						LineStrings: []*geometry.LineString{
+						Rings: []*geometry.LinearRing{
 							{
 								Type: geometry.XY,
 								Points: []*geometry.Point{
@@ -677,7 +680,7 @@ MULTIPOLYGON
 					},
 					{
 						Type: geometry.XY,
-						LineStrings: []*geometry.LineString{
+						Rings: []*geometry.LinearRing{
 							{
 								Type: geometry.XY,
 								Points: []*geometry.Point{
@@ -730,3 +733,621 @@ MULTIPOLYGON
 		})
 	}
 }
+
+func TestWktParser_KeepCoordinateText(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Wkt      []byte
+		Expected *geometry.Point
+		Error    error
+	}{
+		{
+			Name: "Point with decimal coordinates",
+			Wkt:  []byte("POINT (0.1 -20.000000000000000123)"),
+			Expected: &geometry.Point{
+				X: 0.1, Y: -20, Type: geometry.XY,
+				Text: &geometry.CoordinateText{X: "0.1", Y: "-20.000000000000000123"},
+			},
+		},
+		{
+			Name: "Point ZM",
+			Wkt:  []byte("POINT ZM (1.10 2.20 3.30 4.40)"),
+			Expected: &geometry.Point{
+				X: 1.1, Y: 2.2, Z: 3.3, M: 4.4, Type: geometry.XYZM,
+				Text: &geometry.CoordinateText{X: "1.10", Y: "2.20", Z: "3.30", M: "4.40"},
+			},
+		},
+		{
+			Name: "Point M",
+			Wkt:  []byte("POINT M (1 2 1e3)"),
+			Expected: &geometry.Point{
+				X: 1, Y: 2, M: 1000, Type: geometry.XYM,
+				Text: &geometry.CoordinateText{X: "1", Y: "2", M: "1e3"},
+			},
+		},
+	}
+
+	wktParser := parser.New(parser.KeepCoordinateText())
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom, err := wktParser.ParseWKT(bytes.NewReader(tc.Wkt))
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			// compare values, Equal method of *geometry.Point ignores coordinate text
+			point := geom.(*geometry.Point)
+			if diff := cmp.Diff(*point, *tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestWktParser_Dialect(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Dialect  text.Dialect
+		Wkt      []byte
+		Expected geometry.Geometry
+		Error    error
+	}{
+		{
+			Name:     "PostGIS lowercase point",
+			Dialect:  text.PostGIS(),
+			Wkt:      []byte("point(1 2)"),
+			Expected: &geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+		},
+		{
+			Name:     "PostGIS implicit Z",
+			Dialect:  text.PostGIS(),
+			Wkt:      []byte("POINT(1 2 3)"),
+			Expected: &geometry.Point{X: 1, Y: 2, Z: 3, Type: geometry.XYZ},
+		},
+		{
+			Name:     "PostGIS attached M",
+			Dialect:  text.PostGIS(),
+			Wkt:      []byte("POINTM(1 2 3)"),
+			Expected: &geometry.Point{X: 1, Y: 2, M: 3, Type: geometry.XYM},
+		},
+		{
+			Name:    "PostGIS implicit ZM linestring",
+			Dialect: text.PostGIS(),
+			Wkt:     []byte("LINESTRING(1 2 3 4, -5 6 7 8)"),
+			Expected: &geometry.LineString{
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Z: 3, M: 4, Type: geometry.XYZM},
+					{X: -5, Y: 6, Z: 7, M: 8, Type: geometry.XYZM},
+				},
+				Type: geometry.XYZM,
+			},
+		},
+		{
+			Name:    "PostGIS mixed dimensions",
+			Dialect: text.PostGIS(),
+			Wkt:     []byte("LINESTRING(1 2 3, 4 5)"),
+			Error:   parser.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:    "Shapely parenthesized multipoint",
+			Dialect: text.Shapely(),
+			Wkt:     []byte("MULTIPOINT Z ((1 2 3), (4 5 6))"),
+			Expected: &geometry.MultiPoint{
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Z: 3, Type: geometry.XYZ},
+					{X: 4, Y: 5, Z: 6, Type: geometry.XYZ},
+				},
+				Type: geometry.XYZ,
+			},
+		},
+		{
+			Name:    "Oracle implicit Z multipolygon",
+			Dialect: text.Oracle(),
+			Wkt:     []byte("MULTIPOLYGON (((1 1 9, 2 2 9, 3 1 9, 1 1 9)))"),
+			Expected: &geometry.MultiPolygon{
+				Polygons: []*geometry.Polygon{
+					{
+						Rings: []*geometry.LinearRing{
+							{
+								Points: []*geometry.Point{
+									{X: 1, Y: 1, Z: 9, Type: geometry.XYZ},
+									{X: 2, Y: 2, Z: 9, Type: geometry.XYZ},
+									{X: 3, Y: 1, Z: 9, Type: geometry.XYZ},
+									{X: 1, Y: 1, Z: 9, Type: geometry.XYZ},
+								},
+								Type: geometry.XYZ,
+							},
+						},
+						Type: geometry.XYZ,
+					},
+				},
+				Type: geometry.XYZ,
+			},
+		},
+		{
+			Name:    "SQL Server NULL Z",
+			Dialect: text.SQLServer(),
+			Wkt:     []byte("MULTIPOINT ((1 2 NULL 4), (5 6 NULL 8))"),
+			Expected: &geometry.MultiPoint{
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, M: 4, Type: geometry.XYM},
+					{X: 5, Y: 6, M: 8, Type: geometry.XYM},
+				},
+				Type: geometry.XYM,
+			},
+		},
+		{
+			Name:    "SQL Server NULL M",
+			Dialect: text.SQLServer(),
+			Wkt:     []byte("LINESTRING (1 2 3 NULL, 4 5 6 NULL)"),
+			Expected: &geometry.LineString{
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Z: 3, Type: geometry.XYZ},
+					{X: 4, Y: 5, Z: 6, Type: geometry.XYZ},
+				},
+				Type: geometry.XYZ,
+			},
+		},
+		{
+			Name:     "SQL Server NULL Z and M",
+			Dialect:  text.SQLServer(),
+			Wkt:      []byte("POINT (1 2 NULL NULL)"),
+			Expected: &geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+		},
+		{
+			Name:    "SQL Server NULL X",
+			Dialect: text.SQLServer(),
+			Wkt:     []byte("POINT (NULL 2)"),
+			Error:   strconv.ErrSyntax,
+		},
+		{
+			Name:    "SQL Server FULLGLOBE",
+			Dialect: text.SQLServer(),
+			Wkt:     []byte("FULLGLOBE"),
+			Error:   parser.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:    "PostGIS FULLGLOBE",
+			Dialect: text.PostGIS(),
+			Wkt:     []byte("FULLGLOBE"),
+			Error:   parser.ErrUnexpectedGeometryType,
+		},
+		{
+			Name:    "MySQL GEOMCOLLECTION",
+			Dialect: text.MySQL(),
+			Wkt:     []byte("GEOMCOLLECTION(POINT(1 2))"),
+			Error:   parser.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:    "PostGIS GEOMCOLLECTION",
+			Dialect: text.PostGIS(),
+			Wkt:     []byte("GEOMCOLLECTION(POINT(1 2))"),
+			Error:   parser.ErrUnexpectedGeometryType,
+		},
+		{
+			Name:  "Strict lowercase point",
+			Wkt:   []byte("point (1 2)"),
+			Error: parser.ErrUnexpectedGeometryType,
+		},
+		{
+			Name:  "Strict implicit Z",
+			Wkt:   []byte("POINT (1 2 3)"),
+			Error: parser.ErrUnexpectedToken,
+		},
+		{
+			Name:  "Strict parenthesized multipoint",
+			Wkt:   []byte("MULTIPOINT ((1 2), (3 4))"),
+			Error: strconv.ErrSyntax,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			wktParser := parser.New(parser.WithDialect(tc.Dialect))
+			geom, err := wktParser.ParseWKT(bytes.NewReader(tc.Wkt))
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			if diff := cmp.Diff(geom, tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+type circle struct {
+	Center *geometry.Point
+	Radius float64
+}
+
+func (c *circle) GetGeometryType() geometry.Type {
+	return geometry.UndefinedGT
+}
+
+func (c *circle) CoordType() geometry.CoordinateType {
+	if c.Center == nil {
+		return geometry.Empty
+	}
+	return geometry.XY
+}
+
+func (c *circle) IsEmpty() bool {
+	return c.Center == nil
+}
+
+func (c *circle) Dimension() int {
+	return 2
+}
+
+func (c *circle) NumPoints() int {
+	if c.IsEmpty() {
+		return 0
+	}
+	return 1
+}
+
+func (c *circle) Bounds() geometry.Envelope {
+	if c.IsEmpty() {
+		return geometry.EmptyEnvelope()
+	}
+	return geometry.EmptyEnvelope().ExtendToPoint(c.Center).Expand(c.Radius)
+}
+
+func parseCircle(p *parser.Parser, ct geometry.CoordinateType) (geometry.Geometry, error) {
+	if ct == geometry.Empty {
+		return &circle{}, nil
+	}
+
+	center, err := p.ParsePoint(geometry.XY)
+	if err != nil {
+		return nil, err
+	}
+
+	radius, err := p.ParseNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.ExpectToken(text.ClosingParenthesis); err != nil {
+		return nil, err
+	}
+	return &circle{Center: center, Radius: radius}, nil
+}
+
+func TestWktParser_Register(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Dialect  text.Dialect
+		Wkt      []byte
+		Expected geometry.Geometry
+		Error    error
+	}{
+		{
+			Name:     "Circle",
+			Wkt:      []byte("CIRCLE (1 2 3.5)"),
+			Expected: &circle{Center: &geometry.Point{X: 1, Y: 2, Type: geometry.XY}, Radius: 3.5},
+		},
+		{
+			Name:     "Lowercase circle",
+			Dialect:  text.PostGIS(),
+			Wkt:      []byte("circle(-1 2 3)"),
+			Expected: &circle{Center: &geometry.Point{X: -1, Y: 2, Type: geometry.XY}, Radius: 3},
+		},
+		{
+			Name:     "Empty circle",
+			Wkt:      []byte("CIRCLE EMPTY"),
+			Expected: &circle{},
+		},
+		{
+			Name:  "Bad circle",
+			Wkt:   []byte("CIRCLE (1 2 3 4)"),
+			Error: parser.ErrUnexpectedToken,
+		},
+		{
+			Name:  "Unknown keyword",
+			Wkt:   []byte("BOX (1 2, 3 4)"),
+			Error: parser.ErrUnexpectedGeometryType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			wktParser := parser.New(parser.WithDialect(tc.Dialect))
+			wktParser.Register("CIRCLE", parseCircle)
+
+			geom, err := wktParser.ParseWKT(bytes.NewReader(tc.Wkt))
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			if diff := cmp.Diff(geom, tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestWktParser_Repair(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Dialect  text.Dialect
+		Wkt      []byte
+		Expected geometry.Geometry
+		Repairs  []parser.Repair
+		Error    error
+	}{
+		{
+			Name: "Unclosed ring",
+			Wkt:  []byte("POLYGON ((0 0, 1 0, 1 1))"),
+			Expected: &geometry.Polygon{
+				Rings: []*geometry.LinearRing{
+					{
+						Points: []*geometry.Point{
+							{X: 0, Y: 0, Type: geometry.XY},
+							{X: 1, Y: 0, Type: geometry.XY},
+							{X: 1, Y: 1, Type: geometry.XY},
+							{X: 0, Y: 0, Type: geometry.XY},
+						},
+						Type: geometry.XY,
+					},
+				},
+				Type: geometry.XY,
+			},
+			Repairs: []parser.Repair{{Kind: parser.ClosedRing, Offset: 23}},
+		},
+		{
+			Name: "Missing closing parentheses",
+			Wkt:  []byte("MULTILINESTRING ((0 0, 1 1), (2 2, 3 3"),
+			Expected: &geometry.MultiLineString{
+				Lines: []*geometry.LineString{
+					{
+						Points: []*geometry.Point{
+							{X: 0, Y: 0, Type: geometry.XY},
+							{X: 1, Y: 1, Type: geometry.XY},
+						},
+						Type: geometry.XY,
+					},
+					{
+						Points: []*geometry.Point{
+							{X: 2, Y: 2, Type: geometry.XY},
+							{X: 3, Y: 3, Type: geometry.XY},
+						},
+						Type: geometry.XY,
+					},
+				},
+				Type: geometry.XY,
+			},
+			Repairs: []parser.Repair{
+				{Kind: parser.AddedClosingParenthesis, Offset: 38},
+				{Kind: parser.AddedClosingParenthesis, Offset: 38},
+			},
+		},
+		{
+			Name:     "Point without closing parenthesis",
+			Wkt:      []byte("POINT (1 2"),
+			Expected: &geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+			Repairs:  []parser.Repair{{Kind: parser.AddedClosingParenthesis, Offset: 10}},
+		},
+		{
+			Name: "Doubled and trailing commas",
+			Wkt:  []byte("LINESTRING (0 0,, 1 1, 2 2,)"),
+			Expected: &geometry.LineString{
+				Points: []*geometry.Point{
+					{X: 0, Y: 0, Type: geometry.XY},
+					{X: 1, Y: 1, Type: geometry.XY},
+					{X: 2, Y: 2, Type: geometry.XY},
+				},
+				Type: geometry.XY,
+			},
+			Repairs: []parser.Repair{
+				{Kind: parser.RemovedDoubledComma, Offset: 16},
+				{Kind: parser.RemovedTrailingComma, Offset: 26},
+			},
+		},
+		{
+			Name:    "Parenthesized multipoint without closing parentheses",
+			Dialect: text.MySQL(),
+			Wkt:     []byte("MULTIPOINT ((1 2), (3 4"),
+			Expected: &geometry.MultiPoint{
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Type: geometry.XY},
+					{X: 3, Y: 4, Type: geometry.XY},
+				},
+				Type: geometry.XY,
+			},
+			Repairs: []parser.Repair{
+				{Kind: parser.AddedClosingParenthesis, Offset: 23},
+				{Kind: parser.AddedClosingParenthesis, Offset: 23},
+			},
+		},
+		{
+			Name:    "Parenthesized multipoint without closing parenthesis",
+			Dialect: text.MySQL(),
+			Wkt:     []byte("MULTIPOINT ((1 2), (3 4)"),
+			Expected: &geometry.MultiPoint{
+				Points: []*geometry.Point{
+					{X: 1, Y: 2, Type: geometry.XY},
+					{X: 3, Y: 4, Type: geometry.XY},
+				},
+				Type: geometry.XY,
+			},
+			Repairs: []parser.Repair{{Kind: parser.AddedClosingParenthesis, Offset: 24}},
+		},
+		{
+			Name:  "Unrecoverable defect",
+			Wkt:   []byte("LINESTRING (0 0, 1"),
+			Error: parser.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			wktParser := parser.New(parser.WithRepair(), parser.WithDialect(tc.Dialect))
+			geom, err := wktParser.ParseWKT(bytes.NewReader(tc.Wkt))
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			if diff := cmp.Diff(geom, tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			if diff := cmp.Diff(wktParser.Repairs(), tc.Repairs); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestWktParser_Empty(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Wkt      []byte
+		Expected geometry.Geometry
+	}{
+		{Name: "POINT EMPTY", Wkt: []byte("POINT EMPTY"), Expected: &geometry.Point{Type: geometry.Empty}},
+		{Name: "MULTIPOINT EMPTY", Wkt: []byte("MULTIPOINT EMPTY"), Expected: &geometry.MultiPoint{Type: geometry.Empty}},
+		{Name: "LINESTRING EMPTY", Wkt: []byte("LINESTRING EMPTY"), Expected: &geometry.LineString{Type: geometry.Empty}},
+		{Name: "CIRCULARSTRING EMPTY", Wkt: []byte("CIRCULARSTRING EMPTY"), Expected: &geometry.CircularString{Type: geometry.Empty}},
+		{Name: "MULTILINESTRING EMPTY", Wkt: []byte("MULTILINESTRING EMPTY"), Expected: &geometry.MultiLineString{Type: geometry.Empty}},
+		{Name: "POLYGON EMPTY", Wkt: []byte("POLYGON EMPTY"), Expected: &geometry.Polygon{Type: geometry.Empty}},
+		{Name: "MULTIPOLYGON EMPTY", Wkt: []byte("MULTIPOLYGON EMPTY"), Expected: &geometry.MultiPolygon{Type: geometry.Empty}},
+	}
+
+	wktParser := parser.New()
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom, err := wktParser.ParseWKT(bytes.NewReader(tc.Wkt))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			if diff := cmp.Diff(geom, tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			if !geom.IsEmpty() || geom.NumPoints() != 0 {
+				t.Fatalf("\ngeometry is not empty: %+v\n", geom)
+			}
+		})
+	}
+}
+
+func TestWktParser_ParseFlat(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Dialect text.Dialect
+		Wkt     []byte
+	}{
+		{Name: "Point", Wkt: []byte("POINT (30 20)")},
+		{Name: "Point ZM", Wkt: []byte("POINT ZM (1 2 3 4)")},
+		{Name: "Empty point", Wkt: []byte("POINT EMPTY")},
+		{Name: "MultiPoint", Wkt: []byte("MULTIPOINT M (1 2 3, 4 5 6)")},
+		{Name: "LineString", Wkt: []byte("LINESTRING (30 10, 10 30, 40 40)")},
+		{Name: "CircularString", Wkt: []byte("CIRCULARSTRING Z (1 0 1, 0 1 1, -1 0 1)")},
+		{Name: "MultiLineString", Wkt: []byte("MULTILINESTRING ((10 10, 20 20), (40 40, 30 30, 40 20))")},
+		{Name: "Polygon", Wkt: []byte("POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))")},
+		{Name: "Empty polygon", Wkt: []byte("POLYGON EMPTY")},
+		{
+			Name: "MultiPolygon",
+			Wkt:  []byte("MULTIPOLYGON (((40 40, 20 45, 45 30, 40 40)), ((20 35, 10 30, 10 10, 20 35), (30 20, 20 15, 20 25, 30 20)))"),
+		},
+		{Name: "PostGIS multipoint", Dialect: text.PostGIS(), Wkt: []byte("multipoint((1 2 3),(4 5 6))")},
+		{Name: "SQL Server null Z", Dialect: text.SQLServer(), Wkt: []byte("LINESTRING (1 2 NULL 3, 4 5 NULL 6)")},
+		{Name: "Oracle implicit ZM", Dialect: text.Oracle(), Wkt: []byte("POLYGON ((0 0 1 2, 1 0 1 2, 1 1 1 2, 0 0 1 2))")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			wktParser := parser.New(parser.WithDialect(tc.Dialect))
+			flat, err := wktParser.ParseFlat(bytes.NewReader(tc.Wkt))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			geom, err := wktParser.ParseWKT(bytes.NewReader(tc.Wkt))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+				return
+			}
+
+			if diff := cmp.Diff(flat.Geometry(), geom); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			if diff := cmp.Diff(geometry.Flatten(geom), flat, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			if diff := cmp.Diff(geometry.Flatten(geometry.WithSRID(geom, 4326)), flat, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestWktParser_Keywords(t *testing.T) {
+	for gt := geometry.PointGT; gt <= geometry.GeometryCollectionGT; gt++ {
+		if !text.IsKeyword(text.Token(gt.String())) {
+			t.Fatalf("\nkeyword of %s is not in text.Keywords\n", gt)
+		}
+	}
+
+	wktParser := parser.New(parser.WithDialect(text.PostGIS()))
+	for _, keyword := range text.Keywords() {
+		for _, wkt := range []string{string(keyword) + " EMPTY", string(keyword) + "M EMPTY"} {
+			wkt := wkt
+			t.Run(wkt, func(t *testing.T) {
+				if lexeme := text.Lex(wkt)[0]; lexeme.Kind != text.KeywordKind {
+					t.Fatalf("\ngot: %s\nexpected: %s\n", lexeme.Kind, text.KeywordKind)
+				}
+
+				_, err := wktParser.ParseWKT(bytes.NewReader([]byte(wkt)))
+				if err != nil && !errors.Is(err, parser.ErrUnsupportedGeometryType) {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+			})
+		}
+	}
+}
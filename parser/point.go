@@ -9,38 +9,42 @@ import (
 func (p *Parser) parsePoint(ct geometry.CoordinateType) (*geometry.Point, error) {
 	switch ct {
 	case geometry.XY:
-		coords, err := parsePointCoords(p.scanner, geometry.XY)
-		if err != nil {
-			return nil, fmt.Errorf("parsePointCoords: %w", err)
+		if p.inferDimension {
+			return p.parseInferredPoint()
 		}
+		fallthrough
 
-		return &geometry.Point{Type: geometry.XY, X: coords[0], Y: coords[1]}, nil
-
-	case geometry.XYM:
-		coords, err := parsePointCoords(p.scanner, geometry.XYM)
+	case geometry.XYM, geometry.XYZ, geometry.XYZM:
+		coords, literals, err := parsePointCoords(p.scanner, ct)
 		if err != nil {
 			return nil, fmt.Errorf("parsePointCoords: %w", err)
 		}
 
-		return &geometry.Point{Type: geometry.XYM, X: coords[0], Y: coords[1], M: coords[2]}, nil
+		return p.newPoint(ct, coords, literals), nil
 
-	case geometry.XYZ:
-		coords, err := parsePointCoords(p.scanner, geometry.XYZ)
-		if err != nil {
-			return nil, fmt.Errorf("parsePointCoords: %w", err)
-		}
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnexpectedCoordinateType, ct)
+	}
+}
 
-		return &geometry.Point{Type: geometry.XYZ, X: coords[0], Y: coords[1], Z: coords[2]}, nil
+// newPoint returns point with coordinates ordered as in wkt of the specified coordinate type
+func (p *Parser) newPoint(ct geometry.CoordinateType, coords []float64, literals []string) *geometry.Point {
+	point := &geometry.Point{Type: ct, X: coords[0], Y: coords[1]}
+	coordText := geometry.CoordinateText{X: literals[0], Y: literals[1]}
 
+	switch ct {
+	case geometry.XYM:
+		point.M, coordText.M = coords[2], literals[2]
+	case geometry.XYZ:
+		point.Z, coordText.Z = coords[2], literals[2]
 	case geometry.XYZM:
-		coords, err := parsePointCoords(p.scanner, geometry.XYZM)
-		if err != nil {
-			return nil, fmt.Errorf("parsePointCoords: %w", err)
-		}
-
-		return &geometry.Point{Type: geometry.XYZM, X: coords[0], Y: coords[1], Z: coords[2], M: coords[3]}, nil
+		point.Z, coordText.Z = coords[2], literals[2]
+		point.M, coordText.M = coords[3], literals[3]
+	}
 
-	default:
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedCoordinateType, ct)
+	if p.keepCoordinateText {
+		kept := coordText
+		point.Text = &kept
 	}
+	return point
 }
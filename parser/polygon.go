@@ -2,7 +2,6 @@ package parser
 
 import (
 	"fmt"
-	"text/scanner"
 
 	"github.com/IvanZagoskin/wkt/geometry"
 	"github.com/IvanZagoskin/wkt/text"
@@ -11,7 +10,7 @@ import (
 func (p *Parser) parsePolygon(ct geometry.CoordinateType) (*geometry.Polygon, error) {
 	switch ct {
 	case geometry.XY, geometry.XYM, geometry.XYZ, geometry.XYZM:
-		polygon := &geometry.Polygon{Type: ct, LineStrings: []*geometry.LineString{}}
+		polygon := &geometry.Polygon{Type: ct, Rings: []*geometry.LinearRing{}}
 		for {
 			// skip first text.OpeningParenthesis, because parseLineString is not waiting it
 			if err := p.skipTokenAndCheck(text.OpeningParenthesis); err != nil {
@@ -22,13 +21,16 @@ func (p *Parser) parsePolygon(ct geometry.CoordinateType) (*geometry.Polygon, er
 			if err != nil {
 				return nil, fmt.Errorf("parseLineString: %w", err)
 			}
-			polygon.LineStrings = append(polygon.LineStrings, lineString)
+			ring := (*geometry.LinearRing)(lineString)
+			p.closeRing(ring)
+			polygon.Rings = append(polygon.Rings, ring)
 
-			if p.scanner.Scan() == scanner.EOF {
-				return nil, ErrUnexpectedEOF
+			separator, err := p.scanSeparator()
+			if err != nil {
+				return nil, fmt.Errorf("scanSeparator: %w", err)
 			}
 
-			switch text.Token(p.scanner.TokenText()) {
+			switch separator {
 			case text.ClosingParenthesis:
 				return polygon, nil
 			case text.Comma:
@@ -0,0 +1,128 @@
+package parser
+
+import (
+	"fmt"
+	"text/scanner"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+// RepairKind is a kind of defect fixed in repair mode
+type RepairKind uint8
+
+const (
+	UndefinedRepair RepairKind = 0 + iota
+
+	// ClosedRing is a polygon ring closed by appending its first point
+	ClosedRing
+	// AddedClosingParenthesis is a missing closing parenthesis added at the end of input
+	AddedClosingParenthesis
+	// RemovedDoubledComma is an extra comma removed between two elements
+	RemovedDoubledComma
+	// RemovedTrailingComma is a comma removed before closing parenthesis
+	RemovedTrailingComma
+)
+
+// String returns description of repair kind
+func (k RepairKind) String() string {
+	switch k {
+	case ClosedRing:
+		return "closed ring"
+	case AddedClosingParenthesis:
+		return "added closing parenthesis"
+	case RemovedDoubledComma:
+		return "removed doubled comma"
+	case RemovedTrailingComma:
+		return "removed trailing comma"
+	default:
+		return "undefined repair"
+	}
+}
+
+// Repair describes a defect fixed in repair mode
+type Repair struct {
+	Kind RepairKind
+	// Offset is a byte offset in the input where the defect is found
+	Offset int
+}
+
+// String returns description of repair
+func (r Repair) String() string {
+	return fmt.Sprintf("%s at offset %d", r.Kind, r.Offset)
+}
+
+// WithRepair enables repair mode.
+// In repair mode Parser fixes unclosed rings, missing closing parentheses at the end of input,
+// doubled and trailing commas. Repairs made are available via Parser.Repairs.
+func WithRepair() Option {
+	return func(p *Parser) {
+		p.repair = true
+	}
+}
+
+// Repairs returns repairs made during the last ParseWKT call
+func (p *Parser) Repairs() []Repair {
+	return p.repairs
+}
+
+func (p *Parser) addRepair(kind RepairKind, offset int) {
+	p.repairs = append(p.repairs, Repair{Kind: kind, Offset: offset})
+}
+
+// scanSeparator scans the token following an element of a list.
+//
+// In repair mode end of input is treated as closing parenthesis, doubled commas are skipped
+// and trailing comma is treated as closing parenthesis.
+func (p *Parser) scanSeparator() (text.Token, error) {
+	if p.scanner.Scan() == scanner.EOF {
+		if !p.repair {
+			return "", ErrUnexpectedEOF
+		}
+
+		p.addRepair(AddedClosingParenthesis, p.scanner.Pos().Offset)
+		return text.ClosingParenthesis, nil
+	}
+
+	separator := text.Token(p.scanner.TokenText())
+	if separator != text.Comma || !p.repair {
+		return separator, nil
+	}
+
+	for {
+		switch p.peekRune() {
+		case ',':
+			p.scanner.Scan()
+			p.addRepair(RemovedDoubledComma, p.scanner.Position.Offset)
+		case ')':
+			p.addRepair(RemovedTrailingComma, p.scanner.Position.Offset)
+			p.scanner.Scan()
+			return text.ClosingParenthesis, nil
+		default:
+			return text.Comma, nil
+		}
+	}
+}
+
+// skipClosingParenthesis skips closing parenthesis which may be missing at the end of input in repair mode
+func (p *Parser) skipClosingParenthesis() error {
+	if p.repair && p.peekRune() == scanner.EOF {
+		p.addRepair(AddedClosingParenthesis, p.scanner.Pos().Offset)
+		return nil
+	}
+	return p.skipTokenAndCheck(text.ClosingParenthesis)
+}
+
+// closeRing appends the first point to the ring if it is not closed in repair mode
+func (p *Parser) closeRing(ring *geometry.LinearRing) {
+	if !p.repair || ring.IsEmpty() {
+		return
+	}
+
+	if ring.IsClosed() {
+		return
+	}
+
+	ring.Points = append(ring.Points, ring.Points[0].Clone())
+	p.addRepair(ClosedRing, p.scanner.Position.Offset)
+}
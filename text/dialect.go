@@ -0,0 +1,113 @@
+package text
+
+// Dialect describes the WKT flavour of a particular vendor.
+//
+// The zero value is the strict dialect: upper case keywords, explicit Z, M and ZM tags
+// and MULTIPOINT members without parentheses. Parsing rules define what is accepted
+// and writing rules define what is emitted by writers.
+type Dialect struct {
+	Name string
+
+	// Parsing rules
+
+	// CaseInsensitive allows keywords in any case, e.g. "point (1 2)"
+	CaseInsensitive bool
+	// ParenthesizedMultiPoint allows MULTIPOINT members in parentheses, e.g. "MULTIPOINT ((1 2), (3 4))"
+	ParenthesizedMultiPoint bool
+	// ImplicitDimension allows omitting the Z and ZM tags, the coordinate type is taken
+	// from the number of coordinates of the first point, e.g. "POINT (1 2 3)"
+	ImplicitDimension bool
+	// AttachedDimension allows the dimension tag attached to the keyword, e.g. "POINTM (1 2 3)"
+	AttachedDimension bool
+	// NullCoordinates allows NULL in place of absent Z and M coordinates of implicit dimension,
+	// e.g. "POINT (1 2 NULL 4)" is XYM and "POINT (1 2 3 NULL)" is XYZ
+	NullCoordinates bool
+
+	// Aliases maps vendor specific keywords to standard ones
+	Aliases map[Token]Token
+	// Extensions are vendor specific keywords that are valid but have no geometry representation
+	Extensions []Token
+
+	// Writing rules
+
+	// WriteLowercase writes keywords in lower case
+	WriteLowercase bool
+	// WriteCompact omits spaces before parentheses and after commas, e.g. "LINESTRING(1 2,3 4)"
+	WriteCompact bool
+	// WriteParenthesizedMultiPoint writes MULTIPOINT members in parentheses
+	WriteParenthesizedMultiPoint bool
+	// WriteImplicitDimension omits Z and ZM tags. M tag is attached to the keyword if AttachedDimension is set,
+	// otherwise absent Z is written as NULL if NullCoordinates is set.
+	WriteImplicitDimension bool
+}
+
+// PostGIS returns dialect of PostGIS (E)WKT
+func PostGIS() Dialect {
+	return Dialect{
+		Name:                         "postgis",
+		CaseInsensitive:              true,
+		ParenthesizedMultiPoint:      true,
+		ImplicitDimension:            true,
+		AttachedDimension:            true,
+		WriteCompact:                 true,
+		WriteParenthesizedMultiPoint: true,
+	}
+}
+
+// EWKT returns dialect of PostGIS extended WKT as written by ST_AsEWKT:
+// Z and ZM tags are omitted and M tag is attached to the keyword, e.g. "POINTM(1 2 3)"
+func EWKT() Dialect {
+	d := PostGIS()
+	d.Name = "ewkt"
+	d.WriteImplicitDimension = true
+	return d
+}
+
+// Oracle returns dialect of Oracle Spatial WKT
+func Oracle() Dialect {
+	return Dialect{
+		Name:                         "oracle",
+		CaseInsensitive:              true,
+		ParenthesizedMultiPoint:      true,
+		ImplicitDimension:            true,
+		WriteParenthesizedMultiPoint: true,
+		WriteImplicitDimension:       true,
+	}
+}
+
+// SQLServer returns dialect of Microsoft SQL Server WKT
+func SQLServer() Dialect {
+	return Dialect{
+		Name:                         "sqlserver",
+		CaseInsensitive:              true,
+		ParenthesizedMultiPoint:      true,
+		ImplicitDimension:            true,
+		NullCoordinates:              true,
+		Extensions:                   []Token{FULLGLOBE},
+		WriteParenthesizedMultiPoint: true,
+		WriteImplicitDimension:       true,
+	}
+}
+
+// MySQL returns dialect of MySQL WKT
+func MySQL() Dialect {
+	return Dialect{
+		Name:                         "mysql",
+		CaseInsensitive:              true,
+		ParenthesizedMultiPoint:      true,
+		Aliases:                      map[Token]Token{GEOMCOLLECTION: GEOMETRYCOLLECTION},
+		WriteCompact:                 true,
+		WriteParenthesizedMultiPoint: true,
+	}
+}
+
+// Shapely returns dialect of Shapely (GEOS) WKT
+func Shapely() Dialect {
+	return Dialect{
+		Name:                         "shapely",
+		CaseInsensitive:              true,
+		ParenthesizedMultiPoint:      true,
+		ImplicitDimension:            true,
+		WriteParenthesizedMultiPoint: true,
+	}
+}
@@ -0,0 +1,223 @@
+package text
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Kind is a kind of lexeme
+type Kind uint8
+
+const (
+	InvalidKind Kind = 0 + iota
+
+	// KeywordKind is a geometry keyword such as POINT or NULL
+	KeywordKind
+	// DimensionKind is a dimension tag Z, M or ZM
+	DimensionKind
+	// NumberKind is a coordinate value
+	NumberKind
+	// PunctuationKind is a parenthesis, comma or semicolon
+	PunctuationKind
+	// EmptyKind is the EMPTY keyword
+	EmptyKind
+	// SRIDKind is the EWKT SRID prefix such as SRID=4326;
+	SRIDKind
+	// EOFKind is the end of input
+	EOFKind
+)
+
+// String returns name of lexeme kind
+func (k Kind) String() string {
+	switch k {
+	case KeywordKind:
+		return "keyword"
+	case DimensionKind:
+		return "dimension"
+	case NumberKind:
+		return "number"
+	case PunctuationKind:
+		return "punctuation"
+	case EmptyKind:
+		return "empty"
+	case SRIDKind:
+		return "srid"
+	case EOFKind:
+		return "eof"
+	default:
+		return "invalid"
+	}
+}
+
+// Lexeme is a token of wkt text with its position
+type Lexeme struct {
+	Kind Kind
+	Text string
+	// Start and End are byte offsets of the lexeme in the input, End is exclusive
+	Start, End int
+}
+
+// Lexer splits wkt text into lexemes.
+//
+// Lexer never stops on invalid input: unknown characters and words are returned as InvalidKind lexemes.
+type Lexer struct {
+	src string
+	pos int
+}
+
+// NewLexer returns Lexer for the specified wkt text
+func NewLexer(src string) *Lexer {
+	return &Lexer{src: src}
+}
+
+// Lex returns all lexemes of wkt text except the final EOFKind lexeme
+func Lex(src string) []Lexeme {
+	var lexemes []Lexeme
+	for l := NewLexer(src); ; {
+		lexeme := l.Next()
+		if lexeme.Kind == EOFKind {
+			return lexemes
+		}
+		lexemes = append(lexemes, lexeme)
+	}
+}
+
+// Next returns the next lexeme. At the end of input it returns EOFKind lexeme.
+func (l *Lexer) Next() Lexeme {
+	for l.pos < len(l.src) && isSpace(l.src[l.pos]) {
+		l.pos++
+	}
+
+	start := l.pos
+	if start == len(l.src) {
+		return Lexeme{Kind: EOFKind, Start: start, End: start}
+	}
+
+	ch := l.src[start]
+	switch {
+	case isLetter(ch):
+		return l.lexWord(start)
+
+	case isDigit(ch) || ch == '.' || ch == '-' || ch == '+':
+		return l.lexNumber(start)
+
+	case ch == '(' || ch == ')' || ch == ',' || ch == ';':
+		l.pos++
+		return l.lexeme(PunctuationKind, start)
+
+	default:
+		_, size := utf8.DecodeRuneInString(l.src[start:])
+		l.pos += size
+		return l.lexeme(InvalidKind, start)
+	}
+}
+
+func (l *Lexer) lexeme(kind Kind, start int) Lexeme {
+	return Lexeme{Kind: kind, Text: l.src[start:l.pos], Start: start, End: l.pos}
+}
+
+func (l *Lexer) lexWord(start int) Lexeme {
+	for l.pos < len(l.src) && (isLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+		l.pos++
+	}
+
+	word := Token(strings.ToUpper(l.src[start:l.pos]))
+	switch {
+	case word == ZCoordinates || word == MCoordinates || word == ZMCoordinates:
+		return l.lexeme(DimensionKind, start)
+	case word == Empty:
+		return l.lexeme(EmptyKind, start)
+	case word == SRID:
+		return l.lexSRID(start)
+	case word == Null || isKeyword(word):
+		return l.lexeme(KeywordKind, start)
+	default:
+		return l.lexeme(InvalidKind, start)
+	}
+}
+
+// lexSRID lexes the rest of SRID=<digits>; prefix
+func (l *Lexer) lexSRID(start int) Lexeme {
+	end := l.pos
+	if end == len(l.src) || l.src[end] != '=' {
+		return l.lexeme(InvalidKind, start)
+	}
+	end++
+
+	digits := end
+	for end < len(l.src) && isDigit(l.src[end]) {
+		end++
+	}
+	if end == digits {
+		return l.lexeme(InvalidKind, start)
+	}
+
+	if end < len(l.src) && l.src[end] == ';' {
+		end++
+	}
+	l.pos = end
+	return l.lexeme(SRIDKind, start)
+}
+
+func (l *Lexer) lexNumber(start int) Lexeme {
+	if l.src[l.pos] == '-' || l.src[l.pos] == '+' {
+		l.pos++
+	}
+
+	digits := l.skipDigits()
+	if l.pos < len(l.src) && l.src[l.pos] == '.' {
+		l.pos++
+		digits += l.skipDigits()
+	}
+	if digits == 0 {
+		if l.pos == start {
+			l.pos++
+		}
+		return l.lexeme(InvalidKind, start)
+	}
+
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		exponent := l.pos
+		l.pos++
+		if l.pos < len(l.src) && (l.src[l.pos] == '-' || l.src[l.pos] == '+') {
+			l.pos++
+		}
+		if l.skipDigits() == 0 {
+			l.pos = exponent
+		}
+	}
+	return l.lexeme(NumberKind, start)
+}
+
+func (l *Lexer) skipDigits() int {
+	start := l.pos
+	for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+		l.pos++
+	}
+	return l.pos - start
+}
+
+// isKeyword reports whether word is a geometry or vendor keyword, possibly with attached dimension tag
+func isKeyword(word Token) bool {
+	if word == GEOMCOLLECTION || word == FULLGLOBE {
+		return true
+	}
+	for _, tag := range []Token{"", ZMCoordinates, ZCoordinates, MCoordinates} {
+		if IsKeyword(Token(strings.TrimSuffix(string(word), string(tag)))) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+func isLetter(ch byte) bool {
+	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+}
+
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
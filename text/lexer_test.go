@@ -0,0 +1,85 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+func TestLex(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Wkt      string
+		Expected []text.Lexeme
+	}{
+		{
+			Name: "Point Z",
+			Wkt:  "POINT Z (1 -2.5 3e-2)",
+			Expected: []text.Lexeme{
+				{Kind: text.KeywordKind, Text: "POINT", Start: 0, End: 5},
+				{Kind: text.DimensionKind, Text: "Z", Start: 6, End: 7},
+				{Kind: text.PunctuationKind, Text: "(", Start: 8, End: 9},
+				{Kind: text.NumberKind, Text: "1", Start: 9, End: 10},
+				{Kind: text.NumberKind, Text: "-2.5", Start: 11, End: 15},
+				{Kind: text.NumberKind, Text: "3e-2", Start: 16, End: 20},
+				{Kind: text.PunctuationKind, Text: ")", Start: 20, End: 21},
+			},
+		},
+		{
+			Name: "EWKT",
+			Wkt:  "SRID=4326;pointm(1 2 3)",
+			Expected: []text.Lexeme{
+				{Kind: text.SRIDKind, Text: "SRID=4326;", Start: 0, End: 10},
+				{Kind: text.KeywordKind, Text: "pointm", Start: 10, End: 16},
+				{Kind: text.PunctuationKind, Text: "(", Start: 16, End: 17},
+				{Kind: text.NumberKind, Text: "1", Start: 17, End: 18},
+				{Kind: text.NumberKind, Text: "2", Start: 19, End: 20},
+				{Kind: text.NumberKind, Text: "3", Start: 21, End: 22},
+				{Kind: text.PunctuationKind, Text: ")", Start: 22, End: 23},
+			},
+		},
+		{
+			Name: "Empty",
+			Wkt:  "MULTIPOLYGON EMPTY",
+			Expected: []text.Lexeme{
+				{Kind: text.KeywordKind, Text: "MULTIPOLYGON", Start: 0, End: 12},
+				{Kind: text.EmptyKind, Text: "EMPTY", Start: 13, End: 18},
+			},
+		},
+		{
+			Name: "Vendor keywords",
+			Wkt:  "geomcollection FULLGLOBE",
+			Expected: []text.Lexeme{
+				{Kind: text.KeywordKind, Text: "geomcollection", Start: 0, End: 14},
+				{Kind: text.KeywordKind, Text: "FULLGLOBE", Start: 15, End: 24},
+			},
+		},
+		{
+			Name: "Invalid input",
+			Wkt:  "POIN (1 - 2, ×) SRID=",
+			Expected: []text.Lexeme{
+				{Kind: text.InvalidKind, Text: "POIN", Start: 0, End: 4},
+				{Kind: text.PunctuationKind, Text: "(", Start: 5, End: 6},
+				{Kind: text.NumberKind, Text: "1", Start: 6, End: 7},
+				{Kind: text.InvalidKind, Text: "-", Start: 8, End: 9},
+				{Kind: text.NumberKind, Text: "2", Start: 10, End: 11},
+				{Kind: text.PunctuationKind, Text: ",", Start: 11, End: 12},
+				{Kind: text.InvalidKind, Text: "×", Start: 13, End: 15},
+				{Kind: text.PunctuationKind, Text: ")", Start: 15, End: 16},
+				{Kind: text.InvalidKind, Text: "SRID", Start: 17, End: 21},
+				{Kind: text.InvalidKind, Text: "=", Start: 21, End: 22},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			if diff := cmp.Diff(text.Lex(tc.Wkt), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
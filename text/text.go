@@ -14,13 +14,40 @@ const (
 	POLYGON      Token = "POLYGON"
 	MULTIPOLYGON Token = "MULTIPOLYGON"
 
+	GEOMETRYCOLLECTION Token = "GEOMETRYCOLLECTION"
+
+	// Vendor keywords, see Dialect.Aliases and Dialect.Extensions
+	GEOMCOLLECTION Token = "GEOMCOLLECTION"
+	FULLGLOBE      Token = "FULLGLOBE"
+
 	OpeningParenthesis Token = "("
 	ClosingParenthesis Token = ")"
 	Comma              Token = ","
 	Minus              Token = "-"
+	Equals             Token = "="
+	Semicolon          Token = ";"
 
 	ZCoordinates  Token = "Z"
 	MCoordinates  Token = "M"
 	ZMCoordinates Token = "ZM"
 	Empty         Token = "EMPTY"
+	Null          Token = "NULL"
+	SRID          Token = "SRID"
 )
+
+// Keywords returns geometry keywords shared by the parser and the lexer
+func Keywords() []Token {
+	return []Token{
+		POINT, MULTIPOINT, LINESTRING, CIRCULARSTRING, MULTILINESTRING, POLYGON, MULTIPOLYGON, GEOMETRYCOLLECTION,
+	}
+}
+
+// IsKeyword reports whether word is an upper case geometry keyword
+func IsKeyword(word Token) bool {
+	for _, keyword := range Keywords() {
+		if word == keyword {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,372 @@
+package twkb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Decoder reads twkb geometries from io.Reader
+type Decoder struct {
+	r io.ByteReader
+}
+
+// NewDecoder returns Decoder reading from r.
+// Decoder may read more data than needed from r if it does not implement io.ByteReader.
+func NewDecoder(r io.Reader) *Decoder {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &Decoder{r: br}
+}
+
+// Unmarshal returns geometry decoded from twkb
+func Unmarshal(data []byte) (geometry.Geometry, error) {
+	g, _, err := UnmarshalWithIDs(data)
+	return g, err
+}
+
+// UnmarshalWithIDs returns geometry and id list of multi geometry decoded from twkb.
+// Nil ids are returned if there is no id list.
+func UnmarshalWithIDs(data []byte) (geometry.Geometry, []int64, error) {
+	r := bytes.NewReader(data)
+	g, ids, err := NewDecoder(r).DecodeWithIDs()
+	if err == io.EOF {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.Len() != 0 {
+		return nil, nil, fmt.Errorf("%w: %d bytes", ErrTrailingData, r.Len())
+	}
+	return g, ids, nil
+}
+
+// Decode reads the next geometry, io.EOF is returned when there are no more geometries
+func (d *Decoder) Decode() (geometry.Geometry, error) {
+	g, _, err := d.DecodeWithIDs()
+	return g, err
+}
+
+// DecodeWithIDs reads the next geometry and id list of multi geometry.
+// Nil ids are returned if there is no id list.
+func (d *Decoder) DecodeWithIDs() (geometry.Geometry, []int64, error) {
+	header, err := d.r.ReadByte()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gt, ok := geometryType(header & 0x0F)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: type code %d", ErrUnsupportedGeometryType, header&0x0F)
+	}
+
+	meta, err := d.readByte()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read metadata: %w", err)
+	}
+
+	ct := geometry.XY
+	precisions := [4]int{unzigzag(header >> 4), unzigzag(header >> 4)}
+	if meta&metaExtended != 0 {
+		extended, err := d.readByte()
+		if err != nil {
+			return nil, nil, fmt.Errorf("read extended dimensions: %w", err)
+		}
+
+		i := 2
+		switch extended & (extendedZ | extendedM) {
+		case extendedZ:
+			ct = geometry.XYZ
+		case extendedM:
+			ct = geometry.XYM
+		case extendedZ | extendedM:
+			ct = geometry.XYZM
+		}
+		if ct.HasZ() {
+			precisions[i] = int(extended>>2) & 0x07
+			i++
+		}
+		if ct.HasM() {
+			precisions[i] = int(extended >> 5)
+		}
+	}
+
+	var size uint64
+	if meta&metaSize != 0 {
+		if size, err = d.readUvarint(); err != nil {
+			return nil, nil, fmt.Errorf("read size: %w", err)
+		}
+	}
+
+	body := &countingReader{r: d.r}
+	g, ids, err := readGeometry(body, gt, ct, precisions, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	if meta&metaSize != 0 && body.n != size {
+		return nil, nil, fmt.Errorf("%w: %d bytes, expected %d", ErrInvalidSize, body.n, size)
+	}
+	return g, ids, nil
+}
+
+// readGeometry reads optional bounding box and body of geometry
+func readGeometry(
+	r io.ByteReader, gt geometry.Type, ct geometry.CoordinateType, precisions [4]int, meta byte,
+) (geometry.Geometry, []int64, error) {
+	if meta&metaEmpty != 0 {
+		return emptyGeometry(gt), nil, nil
+	}
+
+	dec := newDecoder(r, ct, precisions)
+	if meta&metaBBox != 0 {
+		// bounding box is not kept, it can be computed by geometry.Geometry.Bounds
+		for i := 0; i < 2*dec.dims; i++ {
+			if _, err := dec.readVarint(); err != nil {
+				return nil, nil, fmt.Errorf("read bbox: %w", err)
+			}
+		}
+	}
+	return dec.readBody(gt, meta&metaIDList != 0)
+}
+
+// countingReader counts bytes read from r
+type countingReader struct {
+	r io.ByteReader
+	n uint64
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
+}
+
+func (d *Decoder) readByte() (byte, error) {
+	b, err := d.r.ReadByte()
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return b, err
+}
+
+func (d *Decoder) readUvarint() (uint64, error) {
+	v, err := binary.ReadUvarint(d.r)
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return v, err
+}
+
+func emptyGeometry(gt geometry.Type) geometry.Geometry {
+	switch gt {
+	case geometry.PointGT:
+		return &geometry.Point{Type: geometry.Empty}
+	case geometry.LineStringGT:
+		return &geometry.LineString{Type: geometry.Empty}
+	case geometry.PolygonGT:
+		return &geometry.Polygon{Type: geometry.Empty}
+	case geometry.MultiPointGT:
+		return &geometry.MultiPoint{Type: geometry.Empty}
+	case geometry.MultiLineStringGT:
+		return &geometry.MultiLineString{Type: geometry.Empty}
+	default:
+		return &geometry.MultiPolygon{Type: geometry.Empty}
+	}
+}
+
+// decoder reads coordinates of a geometry as deltas of the previous point
+type decoder struct {
+	*Decoder
+	scale [4]scaler
+	prev  [4]int64
+	dims  int
+	ct    geometry.CoordinateType
+}
+
+func newDecoder(r io.ByteReader, ct geometry.CoordinateType, precisions [4]int) decoder {
+	dec := decoder{Decoder: &Decoder{r: r}, ct: ct, dims: int(ct.NumCoordinates())}
+	for i := 0; i < dec.dims; i++ {
+		dec.scale[i] = newScaler(precisions[i])
+	}
+	return dec
+}
+
+func (dec *decoder) readBody(gt geometry.Type, hasIDs bool) (geometry.Geometry, []int64, error) {
+	if gt == geometry.PointGT {
+		point, err := dec.readCoords()
+		if err != nil {
+			return nil, nil, fmt.Errorf("read point: %w", err)
+		}
+		return point, nil, nil
+	}
+
+	n, err := dec.readCount()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read count: %w", err)
+	}
+	if n == 0 {
+		return emptyGeometry(gt), nil, nil
+	}
+
+	var ids []int64
+	if hasIDs && gt.IsMulti() {
+		ids = make([]int64, 0, capacity(n))
+		for i := 0; i < n; i++ {
+			id, err := dec.readVarint()
+			if err != nil {
+				return nil, nil, fmt.Errorf("read id: %w", err)
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	switch gt {
+	case geometry.LineStringGT:
+		points, err := dec.readPoints(n)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read points: %w", err)
+		}
+		return &geometry.LineString{Type: dec.ct, Points: points}, nil, nil
+
+	case geometry.PolygonGT:
+		polygon, err := dec.readPolygon(n)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read polygon: %w", err)
+		}
+		return polygon, nil, nil
+
+	case geometry.MultiPointGT:
+		points, err := dec.readPoints(n)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read points: %w", err)
+		}
+		return &geometry.MultiPoint{Type: dec.ct, Points: points}, ids, nil
+
+	case geometry.MultiLineStringGT:
+		multiLineString := &geometry.MultiLineString{Type: dec.ct, Lines: make([]*geometry.LineString, 0, capacity(n))}
+		for i := 0; i < n; i++ {
+			count, err := dec.readCount()
+			if err != nil {
+				return nil, nil, fmt.Errorf("read count: %w", err)
+			}
+			points, err := dec.readPoints(count)
+			if err != nil {
+				return nil, nil, fmt.Errorf("read points: %w", err)
+			}
+			line := &geometry.LineString{Type: dec.ct, Points: points}
+			if count == 0 {
+				line.Type = geometry.Empty
+			}
+			multiLineString.Lines = append(multiLineString.Lines, line)
+		}
+		return multiLineString, ids, nil
+
+	default:
+		multiPolygon := &geometry.MultiPolygon{Type: dec.ct, Polygons: make([]*geometry.Polygon, 0, capacity(n))}
+		for i := 0; i < n; i++ {
+			count, err := dec.readCount()
+			if err != nil {
+				return nil, nil, fmt.Errorf("read count: %w", err)
+			}
+			polygon, err := dec.readPolygon(count)
+			if err != nil {
+				return nil, nil, fmt.Errorf("read polygon: %w", err)
+			}
+			multiPolygon.Polygons = append(multiPolygon.Polygons, polygon)
+		}
+		return multiPolygon, ids, nil
+	}
+}
+
+// readPolygon reads n rings of polygon
+func (dec *decoder) readPolygon(n int) (*geometry.Polygon, error) {
+	polygon := &geometry.Polygon{Type: dec.ct, Rings: make([]*geometry.LinearRing, 0, capacity(n))}
+	if n == 0 {
+		polygon.Type = geometry.Empty
+	}
+	for i := 0; i < n; i++ {
+		count, err := dec.readCount()
+		if err != nil {
+			return nil, fmt.Errorf("read count: %w", err)
+		}
+		points, err := dec.readPoints(count)
+		if err != nil {
+			return nil, fmt.Errorf("read ring: %w", err)
+		}
+		ring := &geometry.LinearRing{Type: dec.ct, Points: points}
+		if count == 0 {
+			ring.Type = geometry.Empty
+		}
+		polygon.Rings = append(polygon.Rings, ring)
+	}
+	return polygon, nil
+}
+
+// readPoints reads n points
+func (dec *decoder) readPoints(n int) ([]*geometry.Point, error) {
+	if n == 0 {
+		return nil, nil
+	}
+
+	points := make([]*geometry.Point, 0, capacity(n))
+	for i := 0; i < n; i++ {
+		point, err := dec.readCoords()
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+func (dec *decoder) readCoords() (*geometry.Point, error) {
+	var coords [4]float64
+	for i := 0; i < dec.dims; i++ {
+		delta, err := dec.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		dec.prev[i] += delta
+		coords[i] = dec.scale[i].unscale(float64(dec.prev[i]))
+	}
+
+	point := &geometry.Point{Type: dec.ct, X: coords[0], Y: coords[1]}
+	switch dec.ct {
+	case geometry.XYZ:
+		point.Z = coords[2]
+	case geometry.XYM:
+		point.M = coords[2]
+	case geometry.XYZM:
+		point.Z, point.M = coords[2], coords[3]
+	}
+	return point, nil
+}
+
+func (dec *decoder) readCount() (int, error) {
+	n, err := dec.readUvarint()
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt32 {
+		return 0, fmt.Errorf("%w: count %d", ErrInvalidCount, n)
+	}
+	return int(n), nil
+}
+
+func (dec *decoder) readVarint() (int64, error) {
+	v, err := binary.ReadVarint(dec.r)
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return v, err
+}
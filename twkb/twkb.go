@@ -0,0 +1,127 @@
+// Package twkb implements reading and writing of tiny well-known binary representation of geometries.
+//
+// Coordinates are scaled by a power of ten of precision, rounded to integers and written as
+// zigzag varint deltas of the previous point, see https://github.com/TWKB/Specification
+package twkb
+
+import (
+	"errors"
+	"math"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+var (
+	ErrUnsupportedGeometryType  = errors.New("unsupported geometry type")
+	ErrUnexpectedCoordinateType = errors.New("unexpected coordinate type")
+	ErrInvalidPrecision         = errors.New("invalid precision")
+	ErrInvalidIDs               = errors.New("invalid id list")
+	ErrInvalidCount             = errors.New("invalid count")
+	ErrTrailingData             = errors.New("trailing data")
+	ErrInvalidCoordinate        = errors.New("invalid coordinate")
+	ErrInvalidSize              = errors.New("invalid size")
+	ErrEmptyPart                = errors.New("empty part")
+)
+
+// bits of metadata header
+const (
+	metaBBox     byte = 1 << 0
+	metaSize     byte = 1 << 1
+	metaIDList   byte = 1 << 2
+	metaExtended byte = 1 << 3
+	metaEmpty    byte = 1 << 4
+)
+
+// bits of extended dimensions
+const (
+	extendedZ byte = 1 << 0
+	extendedM byte = 1 << 1
+)
+
+// limits of precision
+const (
+	minPrecision   = -8
+	maxPrecision   = 7
+	maxZMPrecision = 7
+)
+
+// maxPrealloc limits capacity preallocated by a count read from untrusted input
+const maxPrealloc = 1024
+
+func capacity(n int) int {
+	if n > maxPrealloc {
+		return maxPrealloc
+	}
+	return n
+}
+
+// typeCode returns twkb type code of geometry type
+func typeCode(gt geometry.Type) (byte, bool) {
+	switch gt {
+	case geometry.PointGT:
+		return 1, true
+	case geometry.LineStringGT:
+		return 2, true
+	case geometry.PolygonGT:
+		return 3, true
+	case geometry.MultiPointGT:
+		return 4, true
+	case geometry.MultiLineStringGT:
+		return 5, true
+	case geometry.MultiPolygonGT:
+		return 6, true
+	default:
+		return 0, false
+	}
+}
+
+// geometryType returns geometry type of twkb type code
+func geometryType(code byte) (geometry.Type, bool) {
+	for _, gt := range []geometry.Type{
+		geometry.PointGT, geometry.LineStringGT, geometry.PolygonGT,
+		geometry.MultiPointGT, geometry.MultiLineStringGT, geometry.MultiPolygonGT,
+	} {
+		if c, _ := typeCode(gt); c == code {
+			return gt, true
+		}
+	}
+	return geometry.UndefinedGT, false
+}
+
+// scaler converts coordinates to integers of a precision and back.
+// Negative precision divides by the power of ten 10^-p, since its inverse, e.g. 1e-5, isn't exact in float64.
+type scaler struct {
+	pow      float64
+	negative bool
+}
+
+func newScaler(precision int) scaler {
+	if precision < 0 {
+		return scaler{pow: math.Pow10(-precision), negative: true}
+	}
+	return scaler{pow: math.Pow10(precision)}
+}
+
+// scale returns coordinate in units of the precision
+func (s scaler) scale(f float64) float64 {
+	if s.negative {
+		return f / s.pow
+	}
+	return f * s.pow
+}
+
+// unscale returns coordinate of value in units of the precision
+func (s scaler) unscale(v float64) float64 {
+	if s.negative {
+		return v * s.pow
+	}
+	return v / s.pow
+}
+
+func zigzag(n int) byte {
+	return byte((n << 1) ^ (n >> 63))
+}
+
+func unzigzag(b byte) int {
+	return int(b>>1) ^ -int(b&1)
+}
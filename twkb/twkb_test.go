@@ -0,0 +1,396 @@
+package twkb_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/parser"
+	"github.com/IvanZagoskin/wkt/twkb"
+)
+
+func parse(t *testing.T, wkt string) geometry.Geometry {
+	geom, err := parser.New().ParseWKT(bytes.NewReader([]byte(wkt)))
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+	return geom
+}
+
+func TestMarshal_RoundTrip(t *testing.T) {
+	testCases := []string{
+		"POINT (30 20)",
+		"POINT Z (30.2 20.7 34.777)",
+		"POINT M (30.2 20.7 34.777)",
+		"POINT ZM (30.2 -20.7 34.777 63.23)",
+		"POINT EMPTY",
+		"MULTIPOINT Z (30.2 20.7 34.777, 10.2 50.7 64.777)",
+		"MULTIPOINT EMPTY",
+		"LINESTRING M (30.123 10.15 11.22, 10.66 30.23 22.33)",
+		"LINESTRING EMPTY",
+		"MULTILINESTRING ((10 10, 20 20, 10 40), (40 40, 30 30, 40 20, 30 10))",
+		"MULTILINESTRING EMPTY",
+		"POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+		"POLYGON EMPTY",
+		"MULTIPOLYGON (((40 40, 20 45, 45 30, 40 40)), ((20 35, 10 30, 10 10, 30 5, 45 20, 20 35), (30 20, 20 15, 20 25, 30 20)))",
+		"MULTIPOLYGON EMPTY",
+	}
+
+	w := twkb.New(twkb.Precision(3), twkb.ZPrecision(3), twkb.MPrecision(3), twkb.WithBBox(), twkb.WithSize())
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc, func(t *testing.T) {
+			geom := parse(t, tc)
+
+			data, err := w.Marshal(geom)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			decoded, err := twkb.Unmarshal(data)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(geom, decoded); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshal_NegativePrecision(t *testing.T) {
+	for precision := -8; precision < 0; precision++ {
+		w := twkb.New(twkb.Precision(precision))
+		unit := math.Pow10(-precision)
+		for i := -100; i <= 100; i++ {
+			point := &geometry.Point{X: float64(i) * unit, Y: float64(i*7919) * unit, Type: geometry.XY}
+
+			data, err := w.Marshal(point)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			decoded, err := twkb.Unmarshal(data)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if got := decoded.(*geometry.Point); got.X != point.X || got.Y != point.Y {
+				t.Fatalf("\nprecision %d\ngot: %v %v\nexpected: %v %v\n", precision, got.X, got.Y, point.X, point.Y)
+			}
+		}
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Wkt      string
+		Options  []twkb.Option
+		Expected string
+		Decoded  string
+		Error    error
+	}{
+		{
+			Name:     "Linestring",
+			Wkt:      "LINESTRING (1 1, 5 5)",
+			Expected: "02000202020808",
+		},
+		{
+			Name:     "Precision",
+			Wkt:      "POINT (1.23 -4.56)",
+			Options:  []twkb.Option{twkb.Precision(2)},
+			Expected: "4100f6018f07",
+		},
+		{
+			Name:     "Rounding",
+			Wkt:      "POINT (1.234 -4.567)",
+			Options:  []twkb.Option{twkb.Precision(1)},
+			Expected: "2100185b",
+			Decoded:  "POINT (1.2 -4.6)",
+		},
+		{
+			Name:     "Negative precision",
+			Wkt:      "POINT (1234 5678)",
+			Options:  []twkb.Option{twkb.Precision(-2)},
+			Expected: "31001872",
+			Decoded:  "POINT (1200 5700)",
+		},
+		{
+			Name:     "Bounding box and size",
+			Wkt:      "LINESTRING (1 1, 5 5)",
+			Options:  []twkb.Option{twkb.WithBBox(), twkb.WithSize()},
+			Expected: "020309020802080202020808",
+		},
+		{
+			Name:     "Z and M precision",
+			Wkt:      "POINT ZM (1 2 3.5 4.25)",
+			Options:  []twkb.Option{twkb.ZPrecision(1), twkb.MPrecision(2)},
+			Expected: "010847020446d206",
+		},
+		{
+			Name:     "Empty",
+			Wkt:      "POLYGON EMPTY",
+			Options:  []twkb.Option{twkb.WithBBox()},
+			Expected: "0310",
+		},
+		{
+			Name:  "Circularstring",
+			Wkt:   "CIRCULARSTRING (1 0, 0 1, -1 0)",
+			Error: twkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:    "Out of range coordinate",
+			Wkt:     "POINT (1e15 2)",
+			Options: []twkb.Option{twkb.Precision(7)},
+			Error:   twkb.ErrInvalidCoordinate,
+		},
+		{
+			Name:    "Invalid precision",
+			Wkt:     "POINT (1 2)",
+			Options: []twkb.Option{twkb.Precision(8)},
+			Error:   twkb.ErrInvalidPrecision,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			data, err := twkb.New(tc.Options...).Marshal(parse(t, tc.Wkt))
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(tc.Expected, hex.EncodeToString(data)); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			decoded, err := twkb.Unmarshal(data)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			expected := tc.Wkt
+			if tc.Decoded != "" {
+				expected = tc.Decoded
+			}
+			if diff := cmp.Diff(parse(t, expected), decoded); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestAppendTWKB_Error(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Geometry geometry.Geometry
+		Error    error
+	}{
+		{
+			Name: "Empty point of multipoint",
+			Geometry: &geometry.MultiPoint{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {Type: geometry.Empty}},
+			},
+			Error: twkb.ErrEmptyPart,
+		},
+		{
+			Name: "Point of other coordinate type",
+			Geometry: &geometry.LineString{
+				Type:   geometry.XYZ,
+				Points: []*geometry.Point{{X: 1, Y: 2, Z: 3, Type: geometry.XYZ}, {X: 4, Y: 5, Type: geometry.XY}},
+			},
+			Error: twkb.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name: "Nil point",
+			Geometry: &geometry.LineString{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, nil},
+			},
+			Error: twkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name: "NaN coordinate",
+			Geometry: &geometry.LineString{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {X: math.NaN(), Y: 2, Type: geometry.XY}},
+			},
+			Error: twkb.ErrInvalidCoordinate,
+		},
+		{
+			Name:     "Infinite coordinate",
+			Geometry: &geometry.Point{X: 1, Y: math.Inf(-1), Type: geometry.XY},
+			Error:    twkb.ErrInvalidCoordinate,
+		},
+		{
+			Name:     "Nil referenced",
+			Geometry: (*geometry.Referenced)(nil),
+			Error:    twkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:     "Nil geometry",
+			Geometry: (*geometry.MultiPoint)(nil),
+			Error:    twkb.ErrUnsupportedGeometryType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			prefix := []byte{0xAB}
+			data, err := twkb.New(twkb.WithSize()).AppendTWKB(prefix, tc.Geometry)
+			if !errors.Is(err, tc.Error) {
+				t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+			}
+
+			if diff := cmp.Diff(prefix, data); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshalWithIDs(t *testing.T) {
+	geom := parse(t, "MULTIPOINT (1 1, 2 2)")
+
+	data, err := twkb.New().MarshalWithIDs(geom, []int64{2, 3})
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff("040402040602020202", hex.EncodeToString(data)); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	decoded, ids, err := twkb.UnmarshalWithIDs(data)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(geom, decoded); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if diff := cmp.Diff([]int64{2, 3}, ids); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if _, err := twkb.New().MarshalWithIDs(geom, []int64{1}); !errors.Is(err, twkb.ErrInvalidIDs) {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, twkb.ErrInvalidIDs)
+	}
+
+	if _, err := twkb.New().MarshalWithIDs(parse(t, "POINT (1 2)"), []int64{1}); !errors.Is(err, twkb.ErrInvalidIDs) {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, twkb.ErrInvalidIDs)
+	}
+}
+
+func TestUnmarshal_Error(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Twkb  string
+		Error error
+	}{
+		{
+			Name:  "Geometry collection",
+			Twkb:  "0700",
+			Error: twkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:  "Truncated",
+			Twkb:  "020002020208",
+			Error: io.ErrUnexpectedEOF,
+		},
+		{
+			Name:  "Empty input",
+			Twkb:  "",
+			Error: io.ErrUnexpectedEOF,
+		},
+		{
+			Name:  "Invalid size",
+			Twkb:  "0202040202020808",
+			Error: twkb.ErrInvalidSize,
+		},
+		{
+			Name:  "Trailing data",
+			Twkb:  "0100020200",
+			Error: twkb.ErrTrailingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.Twkb)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if _, err := twkb.Unmarshal(data); !errors.Is(err, tc.Error) {
+				t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+			}
+		})
+	}
+}
+
+func TestUnmarshal_ZeroCount(t *testing.T) {
+	for _, tc := range []struct{ Twkb, Wkt string }{
+		{Twkb: "020000", Wkt: "LINESTRING EMPTY"},
+		{Twkb: "040000", Wkt: "MULTIPOINT EMPTY"},
+		{Twkb: "030000", Wkt: "POLYGON EMPTY"},
+	} {
+		data, err := hex.DecodeString(tc.Twkb)
+		if err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+
+		geom, err := twkb.Unmarshal(data)
+		if err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+
+		// compare coordinate types, Equal methods of geometries treat all empty geometries as equal
+		expected := parse(t, tc.Wkt)
+		if geom.GetGeometryType() != expected.GetGeometryType() || geom.CoordType() != expected.CoordType() {
+			t.Fatalf("\ngot: %s %s\nexpected: %s\n", geom.GetGeometryType(), geom.CoordType(), tc.Wkt)
+		}
+	}
+}
+
+func TestDecoder_Stream(t *testing.T) {
+	data, err := hex.DecodeString("0100020202000202020808")
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	dec := twkb.NewDecoder(bytes.NewReader(data))
+	for _, expected := range []string{"POINT (1 1)", "LINESTRING (1 1, 5 5)"} {
+		geom, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+
+		if diff := cmp.Diff(parse(t, expected), geom); diff != "" {
+			t.Fatal("\n-want +got\n", diff)
+		}
+	}
+
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, io.EOF)
+	}
+}
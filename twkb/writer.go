@@ -0,0 +1,384 @@
+package twkb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Writer implements writing twkb
+type Writer struct {
+	precision  int
+	zPrecision int
+	mPrecision int
+	bbox       bool
+	size       bool
+}
+
+// Option configures Writer
+type Option func(w *Writer)
+
+// Precision makes Writer keep n decimal places of X and Y, from -8 to 7. Default precision is zero.
+func Precision(n int) Option {
+	return func(w *Writer) {
+		w.precision = n
+	}
+}
+
+// ZPrecision makes Writer keep n decimal places of Z, from 0 to 7
+func ZPrecision(n int) Option {
+	return func(w *Writer) {
+		w.zPrecision = n
+	}
+}
+
+// MPrecision makes Writer keep n decimal places of M, from 0 to 7
+func MPrecision(n int) Option {
+	return func(w *Writer) {
+		w.mPrecision = n
+	}
+}
+
+// WithBBox makes Writer write bounding box of geometries
+func WithBBox() Option {
+	return func(w *Writer) {
+		w.bbox = true
+	}
+}
+
+// WithSize makes Writer write size of geometries in bytes, so readers can skip them
+func WithSize() Option {
+	return func(w *Writer) {
+		w.size = true
+	}
+}
+
+// New returns Writer
+func New(opts ...Option) *Writer {
+	w := &Writer{}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Marshal returns twkb of geometry with zero precision
+func Marshal(g geometry.Geometry) ([]byte, error) {
+	return New().Marshal(g)
+}
+
+// Marshal returns twkb of geometry
+func (w *Writer) Marshal(g geometry.Geometry) ([]byte, error) {
+	return w.AppendTWKB(nil, g)
+}
+
+// MarshalWithIDs returns twkb of multi geometry with id of every part
+func (w *Writer) MarshalWithIDs(g geometry.Geometry, ids []int64) ([]byte, error) {
+	return w.AppendTWKBWithIDs(nil, g, ids)
+}
+
+// AppendTWKB appends twkb of geometry to dst and returns the extended buffer
+func (w *Writer) AppendTWKB(dst []byte, g geometry.Geometry) ([]byte, error) {
+	return w.AppendTWKBWithIDs(dst, g, nil)
+}
+
+// AppendTWKBWithIDs appends twkb of geometry to dst and returns the extended buffer.
+// Non-nil ids are written as id list of multi geometry, one id per part.
+func (w *Writer) AppendTWKBWithIDs(dst []byte, g geometry.Geometry, ids []int64) ([]byte, error) {
+	origin := len(dst)
+	dst, err := w.appendTWKB(dst, g, ids)
+	if err != nil {
+		// nothing is appended if a part can't be written
+		return dst[:origin], err
+	}
+	return dst, nil
+}
+
+func (w *Writer) appendTWKB(dst []byte, g geometry.Geometry, ids []int64) ([]byte, error) {
+	if r, ok := g.(*geometry.Referenced); ok {
+		if r == nil {
+			return dst, fmt.Errorf("%w: nil %T", ErrUnsupportedGeometryType, r)
+		}
+		g = r.Geometry
+	}
+
+	switch g.(type) {
+	case *geometry.Point, *geometry.MultiPoint, *geometry.LineString,
+		*geometry.MultiLineString, *geometry.Polygon, *geometry.MultiPolygon:
+	default:
+		return dst, fmt.Errorf("%w: %T", ErrUnsupportedGeometryType, g)
+	}
+	if err := validateParts(g); err != nil {
+		return dst, err
+	}
+	code, _ := typeCode(g.GetGeometryType())
+
+	if w.precision < minPrecision || w.precision > maxPrecision {
+		return dst, fmt.Errorf("%w: %d", ErrInvalidPrecision, w.precision)
+	}
+	if w.zPrecision < 0 || w.zPrecision > maxZMPrecision || w.mPrecision < 0 || w.mPrecision > maxZMPrecision {
+		return dst, fmt.Errorf("%w: %d, %d", ErrInvalidPrecision, w.zPrecision, w.mPrecision)
+	}
+
+	ct := g.CoordType()
+	switch ct {
+	case geometry.XY, geometry.XYZ, geometry.XYM, geometry.XYZM, geometry.Empty:
+	default:
+		return dst, fmt.Errorf("%w: %s", ErrUnexpectedCoordinateType, ct)
+	}
+
+	if ids != nil {
+		if n, ok := numParts(g); !ok || n != len(ids) {
+			return dst, fmt.Errorf("%w: %d ids of %s", ErrInvalidIDs, len(ids), g.GetGeometryType())
+		}
+	}
+
+	dst = append(dst, code|zigzag(w.precision)<<4)
+
+	var meta byte
+	switch {
+	case g.IsEmpty():
+		meta |= metaEmpty
+	case ids != nil:
+		meta |= metaIDList
+	}
+	if w.bbox && !g.IsEmpty() {
+		meta |= metaBBox
+	}
+	if w.size {
+		meta |= metaSize
+	}
+	if ct.HasZ() || ct.HasM() {
+		meta |= metaExtended
+	}
+	dst = append(dst, meta)
+
+	if meta&metaExtended != 0 {
+		var extended byte
+		if ct.HasZ() {
+			extended |= extendedZ
+		}
+		if ct.HasM() {
+			extended |= extendedM
+		}
+		dst = append(dst, extended|byte(w.zPrecision)<<2|byte(w.mPrecision)<<5)
+	}
+
+	start := len(dst)
+	if !g.IsEmpty() {
+		e := newEncoder(w, ct)
+		var err error
+		if meta&metaBBox != 0 {
+			if dst, err = e.appendBBox(dst, g.Bounds()); err != nil {
+				return dst, err
+			}
+		}
+		if dst, err = e.appendBody(dst, g, ids); err != nil {
+			return dst, err
+		}
+	}
+
+	if w.size {
+		var buf [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(buf[:], uint64(len(dst)-start))
+		dst = append(dst, buf[:n]...)
+		copy(dst[start+n:], dst[start:len(dst)-n])
+		copy(dst[start:], buf[:n])
+	}
+	return dst, nil
+}
+
+// validateParts rejects nil geometry and nil parts, they have no twkb representation
+func validateParts(g geometry.Geometry) error {
+	return geometry.Walk(g, func(path []int, part geometry.Geometry) error {
+		if geometry.IsNil(part) {
+			return fmt.Errorf("%w: nil %T at %v", ErrUnsupportedGeometryType, part, path)
+		}
+		return nil
+	})
+}
+
+// numParts returns count of parts of multi geometry
+func numParts(g geometry.Geometry) (int, bool) {
+	switch geom := g.(type) {
+	case *geometry.MultiPoint:
+		return len(geom.Points), true
+	case *geometry.MultiLineString:
+		return len(geom.Lines), true
+	case *geometry.MultiPolygon:
+		return len(geom.Polygons), true
+	default:
+		return 0, false
+	}
+}
+
+// encoder writes coordinates of a geometry as deltas of the previous point
+type encoder struct {
+	scale [4]scaler
+	prev  [4]int64
+	dims  int
+	ct    geometry.CoordinateType
+}
+
+func newEncoder(w *Writer, ct geometry.CoordinateType) encoder {
+	e := encoder{ct: ct, dims: int(ct.NumCoordinates())}
+	e.scale[0] = newScaler(w.precision)
+	e.scale[1] = e.scale[0]
+	i := 2
+	if ct.HasZ() {
+		e.scale[i] = newScaler(w.zPrecision)
+		i++
+	}
+	if ct.HasM() {
+		e.scale[i] = newScaler(w.mPrecision)
+	}
+	return e
+}
+
+// appendBBox appends minimum and size of every dimension
+func (e *encoder) appendBBox(dst []byte, env geometry.Envelope) ([]byte, error) {
+	mins := [4]float64{env.MinX, env.MinY}
+	maxs := [4]float64{env.MaxX, env.MaxY}
+	i := 2
+	if e.ct.HasZ() {
+		mins[i], maxs[i] = env.MinZ, env.MaxZ
+		i++
+	}
+	if e.ct.HasM() {
+		mins[i], maxs[i] = env.MinM, env.MaxM
+	}
+
+	for i := 0; i < e.dims; i++ {
+		min, err := e.round(mins[i], i)
+		if err != nil {
+			return dst, err
+		}
+		max, err := e.round(maxs[i], i)
+		if err != nil {
+			return dst, err
+		}
+		dst = appendVarint(dst, min)
+		dst = appendVarint(dst, max-min)
+	}
+	return dst, nil
+}
+
+func (e *encoder) appendBody(dst []byte, g geometry.Geometry, ids []int64) ([]byte, error) {
+	var err error
+	switch geom := g.(type) {
+	case *geometry.Point:
+		return e.appendCoords(dst, geom)
+	case *geometry.MultiPoint:
+		dst = appendUvarint(dst, uint64(len(geom.Points)))
+		dst = appendIDs(dst, ids)
+		for _, point := range geom.Points {
+			if point.IsEmpty() {
+				return dst, fmt.Errorf("%w: empty point of multipoint", ErrEmptyPart)
+			}
+			if dst, err = e.appendCoords(dst, point); err != nil {
+				return dst, err
+			}
+		}
+	case *geometry.LineString:
+		return e.appendPoints(dst, geom.Points)
+	case *geometry.MultiLineString:
+		dst = appendUvarint(dst, uint64(len(geom.Lines)))
+		dst = appendIDs(dst, ids)
+		for _, line := range geom.Lines {
+			if dst, err = e.appendPoints(dst, line.Points); err != nil {
+				return dst, err
+			}
+		}
+	case *geometry.Polygon:
+		return e.appendRings(dst, geom.Rings)
+	case *geometry.MultiPolygon:
+		dst = appendUvarint(dst, uint64(len(geom.Polygons)))
+		dst = appendIDs(dst, ids)
+		for _, polygon := range geom.Polygons {
+			if dst, err = e.appendRings(dst, polygon.Rings); err != nil {
+				return dst, err
+			}
+		}
+	}
+	return dst, nil
+}
+
+func (e *encoder) appendRings(dst []byte, rings []*geometry.LinearRing) ([]byte, error) {
+	dst = appendUvarint(dst, uint64(len(rings)))
+	for _, ring := range rings {
+		var err error
+		if dst, err = e.appendPoints(dst, ring.Points); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+func (e *encoder) appendPoints(dst []byte, points []*geometry.Point) ([]byte, error) {
+	dst = appendUvarint(dst, uint64(len(points)))
+	for _, point := range points {
+		var err error
+		if dst, err = e.appendCoords(dst, point); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+// appendCoords appends coordinates of point which must have the coordinate type of the header
+func (e *encoder) appendCoords(dst []byte, p *geometry.Point) ([]byte, error) {
+	if p.Type != e.ct {
+		return dst, fmt.Errorf("%w: %s point of %s geometry", ErrUnexpectedCoordinateType, p.Type, e.ct)
+	}
+
+	coords := [4]float64{p.X, p.Y}
+	i := 2
+	if e.ct.HasZ() {
+		coords[i] = p.Z
+		i++
+	}
+	if e.ct.HasM() {
+		coords[i] = p.M
+	}
+
+	for i := 0; i < e.dims; i++ {
+		value, err := e.round(coords[i], i)
+		if err != nil {
+			return dst, err
+		}
+		dst = appendVarint(dst, value-e.prev[i])
+		e.prev[i] = value
+	}
+	return dst, nil
+}
+
+// round returns i-th coordinate scaled by precision, it must be finite and fit int64 after scaling
+func (e *encoder) round(f float64, i int) (int64, error) {
+	scaled := math.Round(e.scale[i].scale(f))
+	// -2^63 is exact in float64, 2^63 is the first value out of int64 range
+	if math.IsNaN(scaled) || scaled < math.MinInt64 || scaled >= -math.MinInt64 {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidCoordinate, f)
+	}
+	return int64(scaled), nil
+}
+
+func appendIDs(dst []byte, ids []int64) []byte {
+	for _, id := range ids {
+		dst = appendVarint(dst, id)
+	}
+	return dst
+}
+
+func appendVarint(dst []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	return append(dst, buf[:n]...)
+}
+
+func appendUvarint(dst []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(dst, buf[:n]...)
+}
@@ -0,0 +1,354 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Decoder reads wkb geometries from io.Reader
+type Decoder struct {
+	r     io.Reader
+	order binary.ByteOrder
+	buf   [8]byte
+	// srid is SRID of the last read header
+	srid int
+}
+
+// NewDecoder returns Decoder reading from r
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Unmarshal returns geometry decoded from wkb, SRID of EWKB is skipped
+func Unmarshal(data []byte) (geometry.Geometry, error) {
+	r, err := UnmarshalEWKB(data)
+	if err != nil {
+		return nil, err
+	}
+	return r.Geometry, nil
+}
+
+// UnmarshalEWKB returns geometry decoded from PostGIS extended wkb referenced to its SRID,
+// zero SRID is used if it is absent
+func UnmarshalEWKB(data []byte) (*geometry.Referenced, error) {
+	r := bytes.NewReader(data)
+	g, err := NewDecoder(r).DecodeEWKB()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%w: %d bytes", ErrTrailingData, r.Len())
+	}
+	return g, nil
+}
+
+// Decode reads the next geometry, io.EOF is returned when there are no more geometries.
+// Both ISO (e.g. 1001 for POINT Z) and OGC (e.g. 0x80000001 for POINT Z) type codes are accepted.
+// SRID of EWKB is skipped, see DecodeEWKB.
+func (d *Decoder) Decode() (geometry.Geometry, error) {
+	r, err := d.DecodeEWKB()
+	if err != nil {
+		return nil, err
+	}
+	return r.Geometry, nil
+}
+
+// DecodeEWKB reads the next geometry referenced to its SRID, zero SRID is used if it is absent
+func (d *Decoder) DecodeEWKB() (*geometry.Referenced, error) {
+	gt, ct, err := d.readHeader()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+
+	srid := d.srid
+	g, err := d.readGeometry(gt, ct)
+	if err != nil {
+		return nil, err
+	}
+	return geometry.WithSRID(g, srid), nil
+}
+
+func (d *Decoder) readGeometry(gt geometry.Type, ct geometry.CoordinateType) (geometry.Geometry, error) {
+	switch gt {
+	case geometry.PointGT:
+		return d.readPoint(ct)
+	case geometry.MultiPointGT:
+		return d.readMultiPoint(ct)
+	case geometry.LineStringGT:
+		return d.readLineString(ct)
+	case geometry.CircularStringGT:
+		points, err := d.readPoints(ct)
+		if err != nil {
+			return nil, fmt.Errorf("read points: %w", err)
+		}
+		if len(points) == 0 {
+			return &geometry.CircularString{Type: geometry.Empty}, nil
+		}
+		return &geometry.CircularString{Type: ct, Points: points}, nil
+	case geometry.MultiLineStringGT:
+		return d.readMultiLineString(ct)
+	case geometry.PolygonGT:
+		return d.readPolygon(ct)
+	case geometry.MultiPolygonGT:
+		return d.readMultiPolygon(ct)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedGeometryType, gt)
+	}
+}
+
+// readHeader reads byte order, type code and optional SRID, io.EOF is returned if there is no header
+func (d *Decoder) readHeader() (geometry.Type, geometry.CoordinateType, error) {
+	if _, err := io.ReadFull(d.r, d.buf[:1]); err != nil {
+		return geometry.UndefinedGT, geometry.Undefined, err
+	}
+	order, ok := byteOrder(d.buf[0])
+	if !ok {
+		return geometry.UndefinedGT, geometry.Undefined, fmt.Errorf("%w: %d", ErrInvalidByteOrder, d.buf[0])
+	}
+	d.order = order
+
+	code, err := d.readUint32()
+	if err != nil {
+		return geometry.UndefinedGT, geometry.Undefined, err
+	}
+
+	d.srid = 0
+	if code&flagSRID != 0 {
+		srid, err := d.readUint32()
+		if err != nil {
+			return geometry.UndefinedGT, geometry.Undefined, err
+		}
+		// SRID is a signed 32-bit integer like in PostGIS
+		d.srid = int(int32(srid))
+	}
+	return parseTypeCode(code)
+}
+
+// parseTypeCode returns geometry type and coordinate type of ISO, OGC or EWKB type code
+func parseTypeCode(code uint32) (geometry.Type, geometry.CoordinateType, error) {
+	gt, ct, err := geometry.TypeFromWKBCode(code &^ flags)
+	if err != nil {
+		return geometry.UndefinedGT, geometry.Undefined, fmt.Errorf("%w: type code %#x", ErrUnsupportedGeometryType, code)
+	}
+	if code&(flagZ|flagM) == 0 {
+		return gt, ct, nil
+	}
+	if ct != geometry.XY {
+		// both ISO and OGC dimensions
+		return geometry.UndefinedGT, geometry.Undefined, fmt.Errorf("%w: type code %#x", ErrUnexpectedCoordinateType, code)
+	}
+
+	switch code & (flagZ | flagM) {
+	case flagZ:
+		ct = geometry.XYZ
+	case flagM:
+		ct = geometry.XYM
+	default:
+		ct = geometry.XYZM
+	}
+	return gt, ct, nil
+}
+
+// readMember reads header of a member of collection and checks its types
+func (d *Decoder) readMember(gt geometry.Type, ct geometry.CoordinateType) error {
+	memberGT, memberCT, err := d.readHeader()
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	if memberGT != gt {
+		return fmt.Errorf("%w: %s", ErrUnexpectedGeometryType, memberGT)
+	}
+	if memberCT != ct {
+		return fmt.Errorf("%w: %s", ErrUnexpectedCoordinateType, memberCT)
+	}
+	return nil
+}
+
+// readPoint reads coordinates of point, point with NaN coordinates is empty
+func (d *Decoder) readPoint(ct geometry.CoordinateType) (*geometry.Point, error) {
+	point, err := d.readCoords(ct)
+	if err != nil {
+		return nil, fmt.Errorf("read coords: %w", err)
+	}
+	if math.IsNaN(point.X) && math.IsNaN(point.Y) {
+		return &geometry.Point{Type: geometry.Empty}, nil
+	}
+	return point, nil
+}
+
+func (d *Decoder) readMultiPoint(ct geometry.CoordinateType) (*geometry.MultiPoint, error) {
+	n, err := d.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("read count: %w", err)
+	}
+	if n == 0 {
+		return &geometry.MultiPoint{Type: geometry.Empty}, nil
+	}
+
+	multiPoint := &geometry.MultiPoint{Type: ct, Points: make([]*geometry.Point, 0, capacity(n))}
+	for i := uint32(0); i < n; i++ {
+		if err := d.readMember(geometry.PointGT, ct); err != nil {
+			return nil, fmt.Errorf("read point header: %w", err)
+		}
+		point, err := d.readPoint(ct)
+		if err != nil {
+			return nil, fmt.Errorf("read point: %w", err)
+		}
+		multiPoint.Points = append(multiPoint.Points, point)
+	}
+	return multiPoint, nil
+}
+
+func (d *Decoder) readLineString(ct geometry.CoordinateType) (*geometry.LineString, error) {
+	points, err := d.readPoints(ct)
+	if err != nil {
+		return nil, fmt.Errorf("read points: %w", err)
+	}
+	if len(points) == 0 {
+		return &geometry.LineString{Type: geometry.Empty}, nil
+	}
+	return &geometry.LineString{Type: ct, Points: points}, nil
+}
+
+func (d *Decoder) readMultiLineString(ct geometry.CoordinateType) (*geometry.MultiLineString, error) {
+	n, err := d.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("read count: %w", err)
+	}
+	if n == 0 {
+		return &geometry.MultiLineString{Type: geometry.Empty}, nil
+	}
+
+	multiLineString := &geometry.MultiLineString{Type: ct, Lines: make([]*geometry.LineString, 0, capacity(n))}
+	for i := uint32(0); i < n; i++ {
+		if err := d.readMember(geometry.LineStringGT, ct); err != nil {
+			return nil, fmt.Errorf("read linestring header: %w", err)
+		}
+		line, err := d.readLineString(ct)
+		if err != nil {
+			return nil, fmt.Errorf("read linestring: %w", err)
+		}
+		multiLineString.Lines = append(multiLineString.Lines, line)
+	}
+	return multiLineString, nil
+}
+
+func (d *Decoder) readPolygon(ct geometry.CoordinateType) (*geometry.Polygon, error) {
+	n, err := d.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("read count: %w", err)
+	}
+	if n == 0 {
+		return &geometry.Polygon{Type: geometry.Empty}, nil
+	}
+
+	polygon := &geometry.Polygon{Type: ct, Rings: make([]*geometry.LinearRing, 0, capacity(n))}
+	for i := uint32(0); i < n; i++ {
+		points, err := d.readPoints(ct)
+		if err != nil {
+			return nil, fmt.Errorf("read ring: %w", err)
+		}
+		ring := &geometry.LinearRing{Type: ct, Points: points}
+		if len(points) == 0 {
+			ring.Type = geometry.Empty
+		}
+		polygon.Rings = append(polygon.Rings, ring)
+	}
+	return polygon, nil
+}
+
+func (d *Decoder) readMultiPolygon(ct geometry.CoordinateType) (*geometry.MultiPolygon, error) {
+	n, err := d.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("read count: %w", err)
+	}
+	if n == 0 {
+		return &geometry.MultiPolygon{Type: geometry.Empty}, nil
+	}
+
+	multiPolygon := &geometry.MultiPolygon{Type: ct, Polygons: make([]*geometry.Polygon, 0, capacity(n))}
+	for i := uint32(0); i < n; i++ {
+		if err := d.readMember(geometry.PolygonGT, ct); err != nil {
+			return nil, fmt.Errorf("read polygon header: %w", err)
+		}
+		polygon, err := d.readPolygon(ct)
+		if err != nil {
+			return nil, fmt.Errorf("read polygon: %w", err)
+		}
+		multiPolygon.Polygons = append(multiPolygon.Polygons, polygon)
+	}
+	return multiPolygon, nil
+}
+
+// readPoints reads count of points followed by their coordinates
+func (d *Decoder) readPoints(ct geometry.CoordinateType) ([]*geometry.Point, error) {
+	n, err := d.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("read count: %w", err)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+
+	points := make([]*geometry.Point, 0, capacity(n))
+	for i := uint32(0); i < n; i++ {
+		point, err := d.readCoords(ct)
+		if err != nil {
+			return nil, fmt.Errorf("read coords: %w", err)
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+func (d *Decoder) readCoords(ct geometry.CoordinateType) (*geometry.Point, error) {
+	point := &geometry.Point{Type: ct}
+	coords := [4]*float64{&point.X, &point.Y}
+	switch ct {
+	case geometry.XYZ:
+		coords[2] = &point.Z
+	case geometry.XYM:
+		coords[2] = &point.M
+	case geometry.XYZM:
+		coords[2], coords[3] = &point.Z, &point.M
+	}
+
+	for _, coord := range coords[:ct.NumCoordinates()] {
+		if err := d.read(8); err != nil {
+			return nil, err
+		}
+		*coord = math.Float64frombits(d.order.Uint64(d.buf[:8]))
+	}
+	return point, nil
+}
+
+func (d *Decoder) readUint32() (uint32, error) {
+	if err := d.read(4); err != nil {
+		return 0, err
+	}
+	return d.order.Uint32(d.buf[:4]), nil
+}
+
+func (d *Decoder) read(n int) error {
+	if _, err := io.ReadFull(d.r, d.buf[:n]); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package wkb_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/parser"
+	"github.com/IvanZagoskin/wkt/wkb"
+)
+
+const (
+	xdr = uint8(0)
+	ndr = uint8(1)
+)
+
+// build returns wkb of values written in the byte order
+func build(t *testing.T, order binary.ByteOrder, values ...interface{}) []byte {
+	var buf bytes.Buffer
+	for _, v := range values {
+		if err := binary.Write(&buf, order, v); err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+	return data
+}
+
+func TestUnmarshal(t *testing.T) {
+	le, be := binary.LittleEndian, binary.BigEndian
+	nan := math.NaN()
+
+	testCases := []struct {
+		Name     string
+		Wkb      []byte
+		Expected string
+		Error    error
+	}{
+		{
+			Name:     "Point little endian",
+			Wkb:      decodeHex(t, "0101000000000000000000F03F0000000000000040"),
+			Expected: "POINT (1 2)",
+		},
+		{
+			Name:     "Point big endian",
+			Wkb:      decodeHex(t, "00000000013FF00000000000004000000000000000"),
+			Expected: "POINT (1 2)",
+		},
+		{
+			Name:     "ISO point Z",
+			Wkb:      build(t, le, ndr, uint32(1001), 1.0, 2.0, 3.0),
+			Expected: "POINT Z (1 2 3)",
+		},
+		{
+			Name:     "OGC point Z",
+			Wkb:      build(t, be, xdr, uint32(0x80000001), 1.0, 2.0, 3.0),
+			Expected: "POINT Z (1 2 3)",
+		},
+		{
+			Name:     "ISO point M",
+			Wkb:      build(t, le, ndr, uint32(2001), 1.0, 2.0, 3.0),
+			Expected: "POINT M (1 2 3)",
+		},
+		{
+			Name:     "OGC point ZM",
+			Wkb:      build(t, le, ndr, uint32(0xC0000001), 1.0, 2.0, 3.0, 4.0),
+			Expected: "POINT ZM (1 2 3 4)",
+		},
+		{
+			Name:     "Empty point",
+			Wkb:      build(t, le, ndr, uint32(1), nan, nan),
+			Expected: "POINT EMPTY",
+		},
+		{
+			Name: "Multipoint of mixed byte order",
+			Wkb: append(
+				build(t, le, ndr, uint32(3004), uint32(2), ndr, uint32(3001), 1.0, 2.0, 3.0, 4.0),
+				build(t, be, xdr, uint32(3001), 5.0, 6.0, 7.0, 8.0)...,
+			),
+			Expected: "MULTIPOINT ZM (1 2 3 4, 5 6 7 8)",
+		},
+		{
+			Name:     "Linestring",
+			Wkb:      build(t, be, xdr, uint32(2), uint32(2), 30.0, 10.0, 10.0, 30.0),
+			Expected: "LINESTRING (30 10, 10 30)",
+		},
+		{
+			Name:     "Empty linestring",
+			Wkb:      build(t, le, ndr, uint32(2), uint32(0)),
+			Expected: "LINESTRING EMPTY",
+		},
+		{
+			Name:     "Circularstring",
+			Wkb:      build(t, le, ndr, uint32(2008), uint32(3), 1.0, 0.0, 5.0, 0.0, 1.0, 5.0, -1.0, 0.0, 5.0),
+			Expected: "CIRCULARSTRING M (1 0 5, 0 1 5, -1 0 5)",
+		},
+		{
+			Name: "Multilinestring",
+			Wkb: build(t, le, ndr, uint32(5), uint32(2),
+				ndr, uint32(2), uint32(2), 10.0, 10.0, 20.0, 20.0,
+				ndr, uint32(2), uint32(2), 40.0, 40.0, 30.0, 30.0,
+			),
+			Expected: "MULTILINESTRING ((10 10, 20 20), (40 40, 30 30))",
+		},
+		{
+			Name: "Polygon",
+			Wkb: build(t, le, ndr, uint32(0x80000003), uint32(1),
+				uint32(4), 0.0, 0.0, 1.0, 1.0, 0.0, 1.0, 1.0, 1.0, 1.0, 0.0, 0.0, 1.0,
+			),
+			Expected: "POLYGON Z ((0 0 1, 1 0 1, 1 1 1, 0 0 1))",
+		},
+		{
+			Name: "Multipolygon",
+			Wkb: build(t, be, xdr, uint32(6), uint32(2),
+				xdr, uint32(3), uint32(1), uint32(4), 40.0, 40.0, 20.0, 45.0, 45.0, 30.0, 40.0, 40.0,
+				xdr, uint32(3), uint32(2),
+				uint32(4), 20.0, 35.0, 10.0, 30.0, 10.0, 10.0, 20.0, 35.0,
+				uint32(4), 30.0, 20.0, 20.0, 15.0, 20.0, 25.0, 30.0, 20.0,
+			),
+			Expected: "MULTIPOLYGON (((40 40, 20 45, 45 30, 40 40)), ((20 35, 10 30, 10 10, 20 35), (30 20, 20 15, 20 25, 30 20)))",
+		},
+		{
+			Name:     "Empty multipolygon",
+			Wkb:      build(t, be, xdr, uint32(1006), uint32(0)),
+			Expected: "MULTIPOLYGON EMPTY",
+		},
+		{
+			Name:  "Invalid byte order",
+			Wkb:   build(t, le, uint8(2), uint32(1), 1.0, 2.0),
+			Error: wkb.ErrInvalidByteOrder,
+		},
+		{
+			Name:  "Geometry collection",
+			Wkb:   build(t, le, ndr, uint32(7), uint32(0)),
+			Error: wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:  "Unknown type",
+			Wkb:   build(t, le, ndr, uint32(17), uint32(0)),
+			Error: wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:  "ISO and OGC dimensions",
+			Wkb:   build(t, le, ndr, uint32(0x800003E9), 1.0, 2.0, 3.0),
+			Error: wkb.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:  "Member of another type",
+			Wkb:   build(t, le, ndr, uint32(4), uint32(1), ndr, uint32(2), uint32(0)),
+			Error: wkb.ErrUnexpectedGeometryType,
+		},
+		{
+			Name:  "Member of another coordinate type",
+			Wkb:   build(t, le, ndr, uint32(4), uint32(1), ndr, uint32(1001), 1.0, 2.0, 3.0),
+			Error: wkb.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:  "Truncated",
+			Wkb:   build(t, le, ndr, uint32(2), uint32(2), 1.0, 2.0),
+			Error: io.ErrUnexpectedEOF,
+		},
+		{
+			Name:  "Empty input",
+			Wkb:   []byte{},
+			Error: io.ErrUnexpectedEOF,
+		},
+		{
+			Name:  "Trailing data",
+			Wkb:   build(t, le, ndr, uint32(1), 1.0, 2.0, uint8(0)),
+			Error: wkb.ErrTrailingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom, err := wkb.Unmarshal(tc.Wkb)
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			expected, err := parser.New().ParseWKT(bytes.NewReader([]byte(tc.Expected)))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(geom, expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestDecoder_Stream(t *testing.T) {
+	le := binary.LittleEndian
+	data := build(t, le, ndr, uint32(1), 1.0, 2.0, ndr, uint32(2), uint32(0))
+
+	dec := wkb.NewDecoder(bytes.NewReader(data))
+	for _, expected := range []string{"POINT (1 2)", "LINESTRING EMPTY"} {
+		geom, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+
+		want, err := parser.New().ParseWKT(bytes.NewReader([]byte(expected)))
+		if err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+
+		if diff := cmp.Diff(geom, want); diff != "" {
+			t.Fatal("\n-want +got\n", diff)
+		}
+	}
+
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, io.EOF)
+	}
+}
@@ -0,0 +1,42 @@
+package wkb
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Encoder writes wkb of geometries to io.Writer reusing its buffer between geometries
+type Encoder struct {
+	out     io.Writer
+	writer  *Writer
+	buf     []byte
+	written int64
+}
+
+// NewEncoder returns Encoder writing to out
+func NewEncoder(out io.Writer, opts ...Option) *Encoder {
+	return &Encoder{out: out, writer: New(opts...)}
+}
+
+// Encode writes wkb of geometry and returns the number of bytes written
+func (e *Encoder) Encode(g geometry.Geometry) (int, error) {
+	buf, err := e.writer.AppendWKB(e.buf[:0], g)
+	if err != nil {
+		return 0, fmt.Errorf("append wkb: %w", err)
+	}
+	e.buf = buf
+
+	n, err := e.out.Write(buf)
+	e.written += int64(n)
+	if err != nil {
+		return n, fmt.Errorf("write: %w", err)
+	}
+	return n, nil
+}
+
+// Written returns the total number of bytes written by Encoder
+func (e *Encoder) Written() int64 {
+	return e.written
+}
@@ -0,0 +1,133 @@
+package wkb_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/parser"
+	"github.com/IvanZagoskin/wkt/wkb"
+)
+
+func TestEWKBHex(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Wkt  string
+		SRID int
+		Hex  string
+	}{
+		{
+			Name: "Point with SRID",
+			Wkt:  "POINT (1 2)",
+			SRID: 4326,
+			Hex:  "0101000020E6100000000000000000F03F0000000000000040",
+		},
+		{
+			Name: "Point Z with SRID",
+			Wkt:  "POINT Z (1 2 3)",
+			SRID: 4326,
+			Hex:  "01010000A0E6100000000000000000F03F00000000000000400000000000000840",
+		},
+		{
+			Name: "Point M without SRID",
+			Wkt:  "POINT M (1 2 3)",
+			Hex:  "0101000040000000000000F03F00000000000000400000000000000840",
+		},
+		{
+			Name: "Multipoint with SRID",
+			Wkt:  "MULTIPOINT (1 2)",
+			SRID: 3857,
+			Hex:  "0104000020110F0000010000000101000000000000000000F03F0000000000000040",
+		},
+		{
+			Name: "Point with negative SRID",
+			Wkt:  "POINT (1 2)",
+			SRID: -1,
+			Hex:  "0101000020FFFFFFFF000000000000F03F0000000000000040",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom, err := parser.New().ParseWKT(bytes.NewReader([]byte(tc.Wkt)))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			hex, err := wkb.MarshalEWKBHex(geom, tc.SRID)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(hex, tc.Hex); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			decoded, err := wkb.UnmarshalEWKBHex(tc.Hex)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(decoded, geometry.WithSRID(geom, tc.SRID)); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshalEWKB_SRIDOfGeometry(t *testing.T) {
+	point := &geometry.Point{X: 1, Y: 2, Type: geometry.XY}
+
+	data, err := wkb.MarshalEWKB(geometry.WithSRID(point, 4326), 0)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	referenced, err := wkb.UnmarshalEWKB(data)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(referenced, geometry.WithSRID(point, 4326)); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	// plain wkb decoding skips SRID
+	decoded, err := wkb.Unmarshal(data)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(decoded, geometry.Geometry(point)); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if _, err := wkb.MarshalEWKB(point, math.MaxInt32+1); !errors.Is(err, wkb.ErrInvalidSRID) {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, wkb.ErrInvalidSRID)
+	}
+}
+
+func TestHex(t *testing.T) {
+	hex, err := wkb.MarshalHex(&geometry.Point{X: 1, Y: 2, Type: geometry.XY})
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(hex, "0101000000000000000000F03F0000000000000040"); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if _, err := wkb.UnmarshalHex("01010000"); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, io.ErrUnexpectedEOF)
+	}
+
+	if _, err := wkb.UnmarshalHex("0X"); err == nil {
+		t.Fatal("\nexpected error of invalid hex\n")
+	}
+}
@@ -0,0 +1,47 @@
+package wkb
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// UnmarshalHex returns geometry decoded from hex encoded wkb, SRID of EWKB is skipped
+func UnmarshalHex(s string) (geometry.Geometry, error) {
+	r, err := UnmarshalEWKBHex(s)
+	if err != nil {
+		return nil, err
+	}
+	return r.Geometry, nil
+}
+
+// UnmarshalEWKBHex returns geometry decoded from hex encoded extended wkb as returned by PostGIS
+// referenced to its SRID, zero SRID is used if it is absent
+func UnmarshalEWKBHex(s string) (*geometry.Referenced, error) {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode hex: %w", err)
+	}
+	return UnmarshalEWKB(data)
+}
+
+// MarshalHex returns upper case hex encoded little endian wkb of geometry with ISO type codes
+func MarshalHex(g geometry.Geometry) (string, error) {
+	data, err := Marshal(g)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(data)), nil
+}
+
+// MarshalEWKBHex returns upper case hex encoded little endian extended wkb of geometry as returned by PostGIS.
+// See Writer.AppendEWKB for srid.
+func MarshalEWKBHex(g geometry.Geometry, srid int) (string, error) {
+	data, err := MarshalEWKB(g, srid)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(data)), nil
+}
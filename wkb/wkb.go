@@ -0,0 +1,54 @@
+// Package wkb implements reading and writing of well-known binary representation of geometries
+package wkb
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	ErrInvalidByteOrder         = errors.New("invalid byte order")
+	ErrUnsupportedGeometryType  = errors.New("unsupported geometry type")
+	ErrUnexpectedGeometryType   = errors.New("unexpected geometry type")
+	ErrUnexpectedCoordinateType = errors.New("unexpected coordinate type")
+	ErrTrailingData             = errors.New("trailing data")
+	ErrInvalidSRID              = errors.New("invalid srid")
+)
+
+// byte order markers
+const (
+	bigEndian    byte = 0
+	littleEndian byte = 1
+)
+
+// dimension flags of OGC (extended) WKB type code
+const (
+	flagZ    uint32 = 0x80000000
+	flagM    uint32 = 0x40000000
+	flagSRID uint32 = 0x20000000
+	flags           = flagZ | flagM | flagSRID
+)
+
+// nanBits is a quiet NaN written by PostGIS and GEOS for empty points
+const nanBits uint64 = 0x7FF8000000000000
+
+// maxPrealloc limits capacity preallocated by a count read from untrusted input
+const maxPrealloc = 1024
+
+func byteOrder(marker byte) (binary.ByteOrder, bool) {
+	switch marker {
+	case bigEndian:
+		return binary.BigEndian, true
+	case littleEndian:
+		return binary.LittleEndian, true
+	default:
+		return nil, false
+	}
+}
+
+func capacity(n uint32) int {
+	if n > maxPrealloc {
+		return maxPrealloc
+	}
+	return int(n)
+}
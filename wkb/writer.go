@@ -0,0 +1,273 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Style is a style of type codes of geometries with Z or M coordinates
+type Style uint8
+
+const (
+	// ISO type codes add 1000, 2000 or 3000 for Z, M and ZM, e.g. 1001 for POINT Z
+	ISO Style = iota
+	// OGC type codes set high bits for Z and M, e.g. 0x80000001 for POINT Z
+	OGC
+	// EWKB type codes of PostGIS are OGC type codes which set one more high bit if SRID follows
+	EWKB
+)
+
+// Writer implements writing wkb
+type Writer struct {
+	order binary.ByteOrder
+	style Style
+}
+
+// Option configures Writer
+type Option func(w *Writer)
+
+// WithByteOrder makes Writer write in binary.LittleEndian (default) or binary.BigEndian byte order.
+// Writer returns ErrInvalidByteOrder for other byte orders.
+func WithByteOrder(order binary.ByteOrder) Option {
+	return func(w *Writer) {
+		w.order = order
+	}
+}
+
+// WithStyle makes Writer write type codes of the style, ISO is default
+func WithStyle(style Style) Option {
+	return func(w *Writer) {
+		w.style = style
+	}
+}
+
+// New returns Writer
+func New(opts ...Option) *Writer {
+	w := &Writer{order: binary.LittleEndian}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Marshal returns little endian wkb of geometry with ISO type codes
+func Marshal(g geometry.Geometry) ([]byte, error) {
+	return New().Marshal(g)
+}
+
+// MarshalEWKB returns little endian PostGIS extended wkb of geometry. See Writer.AppendEWKB for srid.
+func MarshalEWKB(g geometry.Geometry, srid int) ([]byte, error) {
+	return New().MarshalEWKB(g, srid)
+}
+
+// Marshal returns wkb of geometry
+func (w *Writer) Marshal(g geometry.Geometry) ([]byte, error) {
+	return w.AppendWKB(nil, g)
+}
+
+// AppendWKB appends wkb of geometry to dst and returns the extended buffer
+func (w *Writer) AppendWKB(dst []byte, g geometry.Geometry) ([]byte, error) {
+	return w.appendGeometry(dst, g, w.style, 0)
+}
+
+// MarshalEWKB returns extended wkb of geometry. See Writer.AppendEWKB for srid.
+func (w *Writer) MarshalEWKB(g geometry.Geometry, srid int) ([]byte, error) {
+	return w.AppendEWKB(nil, g, srid)
+}
+
+// AppendEWKB appends PostGIS extended wkb of geometry to dst and returns the extended buffer.
+//
+// Zero srid is taken from the geometry if it implements geometry.SRIDer.
+// SRID is omitted if srid is still zero, ErrInvalidSRID is returned if it doesn't fit 32-bit integer.
+func (w *Writer) AppendEWKB(dst []byte, g geometry.Geometry, srid int) ([]byte, error) {
+	if s, ok := g.(geometry.SRIDer); ok && srid == 0 {
+		srid = s.GetSRID()
+	}
+	return w.appendGeometry(dst, g, EWKB, srid)
+}
+
+func (w *Writer) appendGeometry(dst []byte, g geometry.Geometry, style Style, srid int) ([]byte, error) {
+	if w.order != binary.LittleEndian && w.order != binary.BigEndian {
+		return dst, fmt.Errorf("%w: %v", ErrInvalidByteOrder, w.order)
+	}
+
+	if srid < math.MinInt32 || srid > math.MaxInt32 {
+		return dst, fmt.Errorf("%w: %d", ErrInvalidSRID, srid)
+	}
+
+	if r, ok := g.(*geometry.Referenced); ok {
+		if r == nil {
+			return dst, fmt.Errorf("%w: nil %T", ErrUnsupportedGeometryType, r)
+		}
+		g = r.Geometry
+	}
+
+	switch g.(type) {
+	case *geometry.Point, *geometry.MultiPoint, *geometry.LineString, *geometry.CircularString,
+		*geometry.MultiLineString, *geometry.Polygon, *geometry.MultiPolygon:
+	default:
+		return dst, fmt.Errorf("%w: %T", ErrUnsupportedGeometryType, g)
+	}
+	if err := validateParts(g); err != nil {
+		return dst, err
+	}
+
+	ct := g.CoordType()
+	switch ct {
+	case geometry.XY, geometry.XYZ, geometry.XYM, geometry.XYZM:
+	case geometry.Empty:
+		ct = geometry.XY
+	default:
+		return dst, fmt.Errorf("%w: %s", ErrUnexpectedCoordinateType, ct)
+	}
+
+	// nothing is appended if a part has coordinate type other than the header one
+	start := len(dst)
+	dst, err := w.appendBody(w.appendHeader(dst, g.GetGeometryType(), ct, style, srid), g, ct, style)
+	if err != nil {
+		return dst[:start], err
+	}
+	return dst, nil
+}
+
+func (w *Writer) appendBody(dst []byte, g geometry.Geometry, ct geometry.CoordinateType, style Style) ([]byte, error) {
+	var err error
+	switch geom := g.(type) {
+	case *geometry.Point:
+		return w.appendPoint(dst, geom, ct)
+	case *geometry.MultiPoint:
+		dst = w.appendUint32(dst, uint32(len(geom.Points)))
+		for _, point := range geom.Points {
+			dst = w.appendHeader(dst, geometry.PointGT, ct, style, 0)
+			if dst, err = w.appendPoint(dst, point, ct); err != nil {
+				return dst, err
+			}
+		}
+	case *geometry.LineString:
+		return w.appendPoints(dst, geom.Points, ct)
+	case *geometry.CircularString:
+		return w.appendPoints(dst, geom.Points, ct)
+	case *geometry.MultiLineString:
+		dst = w.appendUint32(dst, uint32(len(geom.Lines)))
+		for _, line := range geom.Lines {
+			dst = w.appendHeader(dst, geometry.LineStringGT, ct, style, 0)
+			if dst, err = w.appendPoints(dst, line.Points, ct); err != nil {
+				return dst, err
+			}
+		}
+	case *geometry.Polygon:
+		return w.appendRings(dst, geom.Rings, ct)
+	case *geometry.MultiPolygon:
+		dst = w.appendUint32(dst, uint32(len(geom.Polygons)))
+		for _, polygon := range geom.Polygons {
+			dst = w.appendHeader(dst, geometry.PolygonGT, ct, style, 0)
+			if dst, err = w.appendRings(dst, polygon.Rings, ct); err != nil {
+				return dst, err
+			}
+		}
+	}
+	return dst, nil
+}
+
+// validateParts rejects nil geometry and nil parts, they have no wkb representation
+func validateParts(g geometry.Geometry) error {
+	return geometry.Walk(g, func(path []int, part geometry.Geometry) error {
+		if geometry.IsNil(part) {
+			return fmt.Errorf("%w: nil %T at %v", ErrUnsupportedGeometryType, part, path)
+		}
+		return nil
+	})
+}
+
+// appendHeader appends byte order, type code and SRID if it is not zero
+func (w *Writer) appendHeader(dst []byte, gt geometry.Type, ct geometry.CoordinateType, style Style, srid int) []byte {
+	if w.order == binary.BigEndian {
+		dst = append(dst, bigEndian)
+	} else {
+		dst = append(dst, littleEndian)
+	}
+
+	if style != EWKB || srid == 0 {
+		return w.appendUint32(dst, typeCode(gt, ct, style))
+	}
+	dst = w.appendUint32(dst, typeCode(gt, ct, style)|flagSRID)
+	return w.appendUint32(dst, uint32(int32(srid)))
+}
+
+func typeCode(gt geometry.Type, ct geometry.CoordinateType, style Style) uint32 {
+	if style == ISO {
+		return gt.ISOWKBCode(ct)
+	}
+
+	code := gt.WKBCode()
+	if ct.HasZ() {
+		code |= flagZ
+	}
+	if ct.HasM() {
+		code |= flagM
+	}
+	return code
+}
+
+func (w *Writer) appendRings(dst []byte, rings []*geometry.LinearRing, ct geometry.CoordinateType) ([]byte, error) {
+	dst = w.appendUint32(dst, uint32(len(rings)))
+	for _, ring := range rings {
+		var err error
+		if dst, err = w.appendPoints(dst, ring.Points, ct); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+func (w *Writer) appendPoints(dst []byte, points []*geometry.Point, ct geometry.CoordinateType) ([]byte, error) {
+	dst = w.appendUint32(dst, uint32(len(points)))
+	for _, point := range points {
+		var err error
+		if dst, err = w.appendCoords(dst, point, ct); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+// appendPoint appends coordinates of point, empty point is written with NaN coordinates
+func (w *Writer) appendPoint(dst []byte, p *geometry.Point, ct geometry.CoordinateType) ([]byte, error) {
+	if p.IsEmpty() {
+		nan := math.Float64frombits(nanBits)
+		return w.appendCoords(dst, &geometry.Point{X: nan, Y: nan, Z: nan, M: nan, Type: ct}, ct)
+	}
+	return w.appendCoords(dst, p, ct)
+}
+
+// appendCoords appends coordinates of point which must have the coordinate type of the header
+func (w *Writer) appendCoords(dst []byte, p *geometry.Point, ct geometry.CoordinateType) ([]byte, error) {
+	if p.Type != ct {
+		return dst, fmt.Errorf("%w: %s point of %s geometry", ErrUnexpectedCoordinateType, p.Type, ct)
+	}
+
+	dst = w.appendFloat(dst, p.X)
+	dst = w.appendFloat(dst, p.Y)
+	if ct.HasZ() {
+		dst = w.appendFloat(dst, p.Z)
+	}
+	if ct.HasM() {
+		dst = w.appendFloat(dst, p.M)
+	}
+	return dst, nil
+}
+
+func (w *Writer) appendUint32(dst []byte, v uint32) []byte {
+	var buf [4]byte
+	w.order.PutUint32(buf[:], v)
+	return append(dst, buf[:]...)
+}
+
+func (w *Writer) appendFloat(dst []byte, f float64) []byte {
+	var buf [8]byte
+	w.order.PutUint64(buf[:], math.Float64bits(f))
+	return append(dst, buf[:]...)
+}
@@ -0,0 +1,244 @@
+package wkb_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/parser"
+	"github.com/IvanZagoskin/wkt/wkb"
+)
+
+func TestMarshal_RoundTrip(t *testing.T) {
+	testCases := []string{
+		"POINT (30 20)",
+		"POINT Z (30.2 20.7 34.777)",
+		"POINT M (30.2 20.7 34.777)",
+		"POINT ZM (30.2 -20.7 34.777 63.23)",
+		"POINT EMPTY",
+		"MULTIPOINT Z (30.2 20.7 34.777, 10.2 50.7 64.777)",
+		"MULTIPOINT EMPTY",
+		"LINESTRING M (30.123 10.15 11.22, 10.66 30.23 22.33)",
+		"LINESTRING EMPTY",
+		"CIRCULARSTRING ZM (1 0 1 2, 0 1 1 2, -1 0 1 2)",
+		"MULTILINESTRING ((10 10, 20 20, 10 40), (40 40, 30 30, 40 20, 30 10))",
+		"POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+		"POLYGON EMPTY",
+		"MULTIPOLYGON (((40 40, 20 45, 45 30, 40 40)), ((20 35, 10 30, 10 10, 30 5, 45 20, 20 35), (30 20, 20 15, 20 25, 30 20)))",
+		"MULTIPOLYGON EMPTY",
+	}
+
+	writers := map[string]*wkb.Writer{
+		"ISO little endian": wkb.New(),
+		"ISO big endian":    wkb.New(wkb.WithByteOrder(binary.BigEndian)),
+		"OGC little endian": wkb.New(wkb.WithStyle(wkb.OGC)),
+		"OGC big endian":    wkb.New(wkb.WithStyle(wkb.OGC), wkb.WithByteOrder(binary.BigEndian)),
+	}
+
+	for name, w := range writers {
+		for _, tc := range testCases {
+			name, w, tc := name, w, tc
+			t.Run(name+" "+tc, func(t *testing.T) {
+				geom, err := parser.New().ParseWKT(bytes.NewReader([]byte(tc)))
+				if err != nil {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+
+				data, err := w.Marshal(geom)
+				if err != nil {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+
+				decoded, err := wkb.Unmarshal(data)
+				if err != nil {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+
+				if diff := cmp.Diff(decoded, geom); diff != "" {
+					t.Fatal("\n-want +got\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Wkt      string
+		Options  []wkb.Option
+		Expected string
+	}{
+		{
+			Name:     "Little endian",
+			Wkt:      "POINT (1 2)",
+			Expected: "0101000000000000000000F03F0000000000000040",
+		},
+		{
+			Name:     "Big endian",
+			Wkt:      "POINT (1 2)",
+			Options:  []wkb.Option{wkb.WithByteOrder(binary.BigEndian)},
+			Expected: "00000000013FF00000000000004000000000000000",
+		},
+		{
+			Name:     "ISO type code",
+			Wkt:      "POINT Z (1 2 3)",
+			Expected: "01E9030000000000000000F03F00000000000000400000000000000840",
+		},
+		{
+			Name:     "OGC type code",
+			Wkt:      "POINT Z (1 2 3)",
+			Options:  []wkb.Option{wkb.WithStyle(wkb.OGC)},
+			Expected: "0101000080000000000000F03F00000000000000400000000000000840",
+		},
+		{
+			Name:     "Empty point",
+			Wkt:      "POINT EMPTY",
+			Expected: "0101000000000000000000F87F000000000000F87F",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom, err := parser.New().ParseWKT(bytes.NewReader([]byte(tc.Wkt)))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			data, err := wkb.New(tc.Options...).Marshal(geom)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(strings.ToUpper(hex.EncodeToString(data)), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshal_Error(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Writer   *wkb.Writer
+		Geometry geometry.Geometry
+		Error    error
+	}{
+		{
+			Name:     "Unknown coordinate type",
+			Writer:   wkb.New(),
+			Geometry: &geometry.Point{X: 1, Y: 2},
+			Error:    wkb.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:     "Referenced nil",
+			Writer:   wkb.New(),
+			Geometry: &geometry.Referenced{},
+			Error:    wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:     "Nil referenced",
+			Writer:   wkb.New(),
+			Geometry: (*geometry.Referenced)(nil),
+			Error:    wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:   "Point of other coordinate type",
+			Writer: wkb.New(),
+			Geometry: &geometry.LineString{
+				Type:   geometry.XYZ,
+				Points: []*geometry.Point{{X: 1, Y: 2, Z: 3, Type: geometry.XYZ}, {X: 4, Y: 5, Type: geometry.XY}},
+			},
+			Error: wkb.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:     "Nil point",
+			Writer:   wkb.New(),
+			Geometry: &geometry.MultiPoint{Type: geometry.XY, Points: []*geometry.Point{nil}},
+			Error:    wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:     "Nil geometry",
+			Writer:   wkb.New(),
+			Geometry: (*geometry.Point)(nil),
+			Error:    wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:     "Nil line",
+			Writer:   wkb.New(),
+			Geometry: &geometry.MultiLineString{Type: geometry.XY, Lines: []*geometry.LineString{nil}},
+			Error:    wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:     "Nil ring",
+			Writer:   wkb.New(),
+			Geometry: &geometry.Polygon{Type: geometry.XY, Rings: []*geometry.LinearRing{nil}},
+			Error:    wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:     "Nil polygon",
+			Writer:   wkb.New(),
+			Geometry: &geometry.MultiPolygon{Type: geometry.XY, Polygons: []*geometry.Polygon{nil}},
+			Error:    wkb.ErrUnsupportedGeometryType,
+		},
+		{
+			Name:     "Invalid byte order",
+			Writer:   wkb.New(wkb.WithByteOrder(nil)),
+			Geometry: &geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+			Error:    wkb.ErrInvalidByteOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			prefix := []byte{0xAB}
+			data, err := tc.Writer.AppendWKB(prefix, tc.Geometry)
+			if !errors.Is(err, tc.Error) {
+				t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+			}
+
+			if diff := cmp.Diff(data, prefix); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	var out bytes.Buffer
+	enc := wkb.NewEncoder(&out)
+
+	geoms := []geometry.Geometry{
+		&geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+		&geometry.LineString{Type: geometry.Empty},
+	}
+	for _, g := range geoms {
+		if _, err := enc.Encode(g); err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+	}
+
+	if enc.Written() != int64(out.Len()) {
+		t.Fatalf("\ngot: %d\nexpected: %d\n", enc.Written(), out.Len())
+	}
+
+	dec := wkb.NewDecoder(&out)
+	for _, g := range geoms {
+		decoded, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+
+		if diff := cmp.Diff(decoded, g); diff != "" {
+			t.Fatal("\n-want +got\n", diff)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package writer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+)
+
+// Encoder writes wkt of geometries to io.Writer reusing its buffer between geometries
+type Encoder struct {
+	out     io.Writer
+	writer  *Writer
+	buf     []byte
+	written int64
+}
+
+// NewEncoder returns Encoder writing to out
+func NewEncoder(out io.Writer, opts ...Option) *Encoder {
+	return &Encoder{out: out, writer: New(opts...)}
+}
+
+// Encode writes wkt of geometry and returns the number of bytes written
+func (e *Encoder) Encode(g geometry.Geometry) (int, error) {
+	return e.encode(g, false)
+}
+
+// EncodeLine writes wkt of geometry followed by a newline and returns the number of bytes written
+func (e *Encoder) EncodeLine(g geometry.Geometry) (int, error) {
+	return e.encode(g, true)
+}
+
+// Written returns the total number of bytes written by Encoder
+func (e *Encoder) Written() int64 {
+	return e.written
+}
+
+func (e *Encoder) encode(g geometry.Geometry, newline bool) (int, error) {
+	buf, err := e.writer.AppendWKT(e.buf[:0], g)
+	if err != nil {
+		return 0, fmt.Errorf("append wkt: %w", err)
+	}
+	if newline {
+		buf = append(buf, '\n')
+	}
+	e.buf = buf
+
+	n, err := e.out.Write(buf)
+	e.written += int64(n)
+	if err != nil {
+		return n, fmt.Errorf("write: %w", err)
+	}
+	return n, nil
+}
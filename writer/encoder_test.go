@@ -0,0 +1,81 @@
+package writer_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/writer"
+)
+
+func TestEncoder(t *testing.T) {
+	var out bytes.Buffer
+	enc := writer.NewEncoder(&out, writer.Compact())
+
+	geoms := []geometry.Geometry{
+		&geometry.Point{X: 1, Y: 2, Type: geometry.XY},
+		&geometry.LineString{Type: geometry.Empty},
+		&geometry.MultiPoint{Type: geometry.XY, Points: []*geometry.Point{
+			{X: 1, Y: 2, Type: geometry.XY},
+			{X: 3, Y: 4, Type: geometry.XY},
+		}},
+	}
+
+	var total int
+	for _, g := range geoms {
+		n, err := enc.EncodeLine(g)
+		if err != nil {
+			t.Fatalf("\nunexpected error:%v\n\n", err)
+		}
+		total += n
+	}
+
+	n, err := enc.Encode(&geometry.Point{X: 5, Y: 6, Type: geometry.XY})
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+	total += n
+
+	expected := "POINT(1 2)\nLINESTRING EMPTY\nMULTIPOINT(1 2,3 4)\nPOINT(5 6)"
+	if diff := cmp.Diff(out.String(), expected); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+
+	if enc.Written() != int64(len(expected)) || total != len(expected) {
+		t.Fatalf("\ngot: %d, %d\nexpected: %d\n", enc.Written(), total, len(expected))
+	}
+}
+
+func TestEncoder_Error(t *testing.T) {
+	var out bytes.Buffer
+	enc := writer.NewEncoder(&out)
+
+	_, err := enc.Encode(&geometry.Point{X: 1, Y: 2})
+	if !errors.Is(err, writer.ErrUnexpectedCoordinateType) {
+		t.Fatalf("\ngot: %v\nexpected error: %s\n", err, writer.ErrUnexpectedCoordinateType)
+	}
+
+	if out.Len() != 0 || enc.Written() != 0 {
+		t.Fatalf("\nunexpected output: %q\n", out.String())
+	}
+}
+
+func BenchmarkEncoder(b *testing.B) {
+	line := &geometry.LineString{Type: geometry.XY}
+	for i := 0; i < 100; i++ {
+		line.Points = append(line.Points, &geometry.Point{X: float64(i), Y: float64(i) / 3, Type: geometry.XY})
+	}
+
+	var out bytes.Buffer
+	enc := writer.NewEncoder(&out)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		out.Reset()
+		if _, err := enc.EncodeLine(line); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package writer
+
+import (
+	"strconv"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+// MarshalEWKT returns PostGIS extended wkt of geometry, e.g. "SRID=4326;POINT(30 20)".
+// See Writer.AppendEWKT for srid.
+func MarshalEWKT(g geometry.Geometry, srid int) ([]byte, error) {
+	return New(WithDialect(text.EWKT())).MarshalEWKT(g, srid)
+}
+
+// MarshalEWKT returns extended wkt of geometry. See Writer.AppendEWKT for srid.
+func (w *Writer) MarshalEWKT(g geometry.Geometry, srid int) ([]byte, error) {
+	return w.AppendEWKT(nil, g, srid)
+}
+
+// AppendEWKT appends extended wkt of geometry to dst and returns the extended buffer.
+//
+// Zero srid is taken from the geometry if it implements geometry.SRIDer.
+// SRID prefix is omitted if srid is still zero.
+// Geometry is written in the dialect of Writer, text.EWKT follows PostGIS conventions.
+func (w *Writer) AppendEWKT(dst []byte, g geometry.Geometry, srid int) ([]byte, error) {
+	if s, ok := g.(geometry.SRIDer); ok && srid == 0 {
+		srid = s.GetSRID()
+	}
+
+	if srid != 0 {
+		dst = append(dst, text.SRID...)
+		dst = append(dst, text.Equals...)
+		dst = strconv.AppendInt(dst, int64(srid), 10)
+		dst = append(dst, text.Semicolon...)
+	}
+	return w.AppendWKT(dst, g)
+}
@@ -0,0 +1,93 @@
+package writer_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/parser"
+	"github.com/IvanZagoskin/wkt/writer"
+)
+
+func TestMarshalEWKT(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Wkt        string
+		SRID       int
+		Referenced int
+		Expected   string
+		Error      error
+	}{
+		{
+			Name:     "SRID argument",
+			Wkt:      "POINT (30 20)",
+			SRID:     4326,
+			Expected: "SRID=4326;POINT(30 20)",
+		},
+		{
+			Name:     "Without SRID",
+			Wkt:      "LINESTRING Z (1 2 3, 4 5 6)",
+			Expected: "LINESTRING(1 2 3,4 5 6)",
+		},
+		{
+			Name:       "SRID of geometry",
+			Wkt:        "POINT M (1 2 3)",
+			Referenced: 3857,
+			Expected:   "SRID=3857;POINTM(1 2 3)",
+		},
+		{
+			Name:       "SRID argument overrides SRID of geometry",
+			Wkt:        "MULTIPOINT ZM (1 2 3 4, 5 6 7 8)",
+			SRID:       4326,
+			Referenced: 3857,
+			Expected:   "SRID=4326;MULTIPOINT((1 2 3 4),(5 6 7 8))",
+		},
+		{
+			Name:     "Empty",
+			Wkt:      "POLYGON EMPTY",
+			SRID:     4326,
+			Expected: "SRID=4326;POLYGON EMPTY",
+		},
+		{
+			Name:  "Unsupported geometry",
+			SRID:  4326,
+			Error: writer.ErrUnsupportedGeometry,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			var geom geometry.Geometry = &geometry.Referenced{}
+			if tc.Wkt != "" {
+				var err error
+				geom, err = parser.New().ParseWKT(bytes.NewReader([]byte(tc.Wkt)))
+				if err != nil {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+			}
+			if tc.Referenced != 0 {
+				geom = geometry.WithSRID(geom, tc.Referenced)
+			}
+
+			ewkt, err := writer.MarshalEWKT(geom, tc.SRID)
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(string(ewkt), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
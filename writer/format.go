@@ -0,0 +1,125 @@
+package writer
+
+import (
+	"strconv"
+
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+// Option configures Writer
+type Option func(w *Writer)
+
+// Precision makes Writer format coordinates with exactly n decimal places
+func Precision(n int) Option {
+	return func(w *Writer) {
+		w.precision = n
+	}
+}
+
+// MaxPrecision makes Writer format coordinates with at most n decimal places
+func MaxPrecision(n int) Option {
+	return func(w *Writer) {
+		w.precision = n
+		w.trimZeros = true
+	}
+}
+
+// ShortestFloat makes Writer format coordinates with the fewest digits that parse back to the same float64.
+// It is the default formatting.
+func ShortestFloat() Option {
+	return func(w *Writer) {
+		w.precision = -1
+	}
+}
+
+// TrimZeros makes Writer remove trailing zeros of the fractional part of coordinates
+func TrimZeros() Option {
+	return func(w *Writer) {
+		w.trimZeros = true
+	}
+}
+
+// Compact makes Writer omit optional spaces, e.g. "LINESTRING(1 2,3 4)"
+func Compact() Option {
+	return func(w *Writer) {
+		w.dialect.WriteCompact = true
+	}
+}
+
+// Indent makes Writer pretty-print nested geometries over multiple lines indented with indent
+func Indent(indent string) Option {
+	return func(w *Writer) {
+		w.indent = indent
+	}
+}
+
+// WithDialect makes Writer write WKT of the specified dialect
+func WithDialect(d text.Dialect) Option {
+	return func(w *Writer) {
+		w.dialect = d
+	}
+}
+
+// appendNumber appends formatted coordinate.
+// The original text is used with the shortest formatting only and only if it is still the value of coordinate.
+func (w *Writer) appendNumber(dst []byte, f float64, literal string) []byte {
+	start := len(dst)
+	switch {
+	case w.precision >= 0:
+		dst = strconv.AppendFloat(dst, f, 'f', w.precision, 64)
+		dst = trimNegativeZero(dst, start)
+	case literal != "" && literalOf(literal, f):
+		dst = append(dst, literal...)
+	default:
+		dst = strconv.AppendFloat(dst, f, 'f', -1, 64)
+	}
+
+	if w.trimZeros {
+		dst = trimZeros(dst, start)
+	}
+	return dst
+}
+
+// literalOf reports whether literal is the text of f
+func literalOf(literal string, f float64) bool {
+	parsed, err := strconv.ParseFloat(literal, 64)
+	return err == nil && parsed == f
+}
+
+// trimZeros removes trailing zeros of the fractional part of the number started at start
+func trimZeros(dst []byte, start int) []byte {
+	dot := -1
+	for i := start; i < len(dst); i++ {
+		switch dst[i] {
+		case '.':
+			dot = i
+		case 'e', 'E':
+			return dst
+		}
+	}
+	if dot < 0 {
+		return dst
+	}
+
+	end := len(dst)
+	for end > dot+1 && dst[end-1] == '0' {
+		end--
+	}
+	if end == dot+1 {
+		end = dot
+	}
+	return dst[:end]
+}
+
+// trimNegativeZero removes sign of the number started at start if it is rounded to zero
+func trimNegativeZero(dst []byte, start int) []byte {
+	if start >= len(dst) || dst[start] != '-' {
+		return dst
+	}
+	for _, c := range dst[start+1:] {
+		if c != '0' && c != '.' {
+			return dst
+		}
+	}
+	return append(dst[:start], dst[start+1:]...)
+}
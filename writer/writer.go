@@ -0,0 +1,264 @@
+package writer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/text"
+)
+
+var (
+	ErrUnsupportedGeometry      = errors.New("unsupported geometry")
+	ErrUnexpectedCoordinateType = errors.New("unexpected coordinate type")
+	ErrInvalidCoordinate        = errors.New("invalid coordinate")
+	ErrEmptyPart                = errors.New("empty part")
+)
+
+// Writer implements writing wkt
+type Writer struct {
+	// precision is a number of decimal places, negative value means the shortest round-trip formatting
+	precision int
+	trimZeros bool
+	indent    string
+	dialect   text.Dialect
+}
+
+// New returns Writer. Options are applied in order.
+func New(opts ...Option) *Writer {
+	w := &Writer{precision: -1}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Marshal returns wkt of geometry
+func Marshal(g geometry.Geometry) ([]byte, error) {
+	return New().Marshal(g)
+}
+
+// Marshal returns wkt of geometry
+func (w *Writer) Marshal(g geometry.Geometry) ([]byte, error) {
+	return w.AppendWKT(nil, g)
+}
+
+// AppendWKT appends wkt of geometry to dst and returns the extended buffer
+func (w *Writer) AppendWKT(dst []byte, g geometry.Geometry) ([]byte, error) {
+	if r, ok := g.(*geometry.Referenced); ok {
+		if r == nil {
+			return dst, fmt.Errorf("%w: nil %T", ErrUnsupportedGeometry, r)
+		}
+		g = r.Geometry
+	}
+
+	switch g.(type) {
+	case *geometry.Point, *geometry.MultiPoint, *geometry.LineString, *geometry.CircularString,
+		*geometry.MultiLineString, *geometry.Polygon, *geometry.MultiPolygon:
+	default:
+		return dst, fmt.Errorf("%w: %T", ErrUnsupportedGeometry, g)
+	}
+	if geometry.IsNil(g) {
+		return dst, fmt.Errorf("%w: nil %T", ErrUnsupportedGeometry, g)
+	}
+
+	dst = w.appendKeyword(dst, g.GetGeometryType().String())
+	if g.IsEmpty() {
+		dst = append(dst, ' ')
+		return w.appendKeyword(dst, string(text.Empty)), nil
+	}
+
+	ct := g.CoordType()
+	switch ct {
+	case geometry.XY, geometry.XYZ, geometry.XYM, geometry.XYZM:
+	default:
+		return dst, fmt.Errorf("%w: %s", ErrUnexpectedCoordinateType, ct)
+	}
+	if ct == geometry.XYM && w.tag(ct) == "" && !w.nullZ(ct) {
+		return dst, fmt.Errorf("%w: %s can't be written in %s dialect", ErrUnexpectedCoordinateType, ct, w.dialect.Name)
+	}
+	if err := validate(g, ct); err != nil {
+		return dst, err
+	}
+
+	switch tag := w.tag(ct); {
+	case tag == "":
+		if !w.dialect.WriteCompact {
+			dst = append(dst, ' ')
+		}
+	case w.dialect.WriteImplicitDimension:
+		// attached tag, e.g. POINTM
+		dst = w.appendKeyword(dst, tag)
+	default:
+		dst = append(dst, ' ')
+		dst = w.appendKeyword(dst, tag)
+		dst = append(dst, ' ')
+	}
+
+	switch geom := g.(type) {
+	case *geometry.Point:
+		dst = append(dst, text.OpeningParenthesis...)
+		dst = w.appendCoords(dst, geom)
+		dst = append(dst, text.ClosingParenthesis...)
+	case *geometry.MultiPoint:
+		if w.dialect.WriteParenthesizedMultiPoint {
+			dst = w.appendList(dst, 0, len(geom.Points), false, func(dst []byte, i int) []byte {
+				dst = append(dst, text.OpeningParenthesis...)
+				dst = w.appendCoords(dst, geom.Points[i])
+				return append(dst, text.ClosingParenthesis...)
+			})
+			break
+		}
+		dst = w.appendPoints(dst, geom.Points)
+	case *geometry.LineString:
+		dst = w.appendPoints(dst, geom.Points)
+	case *geometry.CircularString:
+		dst = w.appendPoints(dst, geom.Points)
+	case *geometry.MultiLineString:
+		dst = w.appendList(dst, 0, len(geom.Lines), true, func(dst []byte, i int) []byte {
+			return w.appendPoints(dst, geom.Lines[i].Points)
+		})
+	case *geometry.Polygon:
+		dst = w.appendRings(dst, 0, geom.Rings)
+	case *geometry.MultiPolygon:
+		dst = w.appendList(dst, 0, len(geom.Polygons), true, func(dst []byte, i int) []byte {
+			return w.appendRings(dst, 1, geom.Polygons[i].Rings)
+		})
+	}
+	return dst, nil
+}
+
+// validate checks that parts of geometry are not empty, have the same coordinate type
+// and finite coordinates, so nothing is written for invalid geometry
+func validate(g geometry.Geometry, ct geometry.CoordinateType) error {
+	return geometry.Walk(g, func(path []int, part geometry.Geometry) error {
+		switch {
+		case geometry.IsNil(part) || part.IsEmpty():
+			return fmt.Errorf("%w: %v", ErrEmptyPart, path)
+		case part.CoordType() != ct:
+			return fmt.Errorf("%w: %s at %v, expected %s", ErrUnexpectedCoordinateType, part.CoordType(), path, ct)
+		}
+
+		p, ok := part.(*geometry.Point)
+		if !ok {
+			return nil
+		}
+		coords := []float64{p.X, p.Y}
+		if ct.HasZ() {
+			coords = append(coords, p.Z)
+		}
+		if ct.HasM() {
+			coords = append(coords, p.M)
+		}
+		for _, c := range coords {
+			if math.IsNaN(c) || math.IsInf(c, 0) {
+				return fmt.Errorf("%w: %v at %v", ErrInvalidCoordinate, c, path)
+			}
+		}
+		return nil
+	})
+}
+
+// tag returns dimension tag of coordinate type written by the dialect
+func (w *Writer) tag(ct geometry.CoordinateType) string {
+	if !w.dialect.WriteImplicitDimension {
+		return ct.Tag()
+	}
+	if ct == geometry.XYM && w.dialect.AttachedDimension {
+		return string(text.MCoordinates)
+	}
+	return ""
+}
+
+// nullZ reports whether absent Z of coordinate type is written as NULL
+func (w *Writer) nullZ(ct geometry.CoordinateType) bool {
+	return ct == geometry.XYM && w.dialect.WriteImplicitDimension && !w.dialect.AttachedDimension &&
+		w.dialect.NullCoordinates
+}
+
+// appendRings appends comma separated rings in parentheses
+func (w *Writer) appendRings(dst []byte, depth int, rings []*geometry.LinearRing) []byte {
+	return w.appendList(dst, depth, len(rings), true, func(dst []byte, i int) []byte {
+		return w.appendPoints(dst, rings[i].Points)
+	})
+}
+
+// appendPoints appends comma separated points in parentheses
+func (w *Writer) appendPoints(dst []byte, points []*geometry.Point) []byte {
+	return w.appendList(dst, 0, len(points), false, func(dst []byte, i int) []byte {
+		return w.appendCoords(dst, points[i])
+	})
+}
+
+// appendList appends n comma separated items in parentheses.
+// Nested lists are written one item per line when pretty-printing.
+func (w *Writer) appendList(dst []byte, depth, n int, nested bool, appendItem func(dst []byte, i int) []byte) []byte {
+	pretty := nested && w.indent != ""
+	dst = append(dst, text.OpeningParenthesis...)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			dst = append(dst, text.Comma...)
+			if !pretty && !w.dialect.WriteCompact {
+				dst = append(dst, ' ')
+			}
+		}
+		if pretty {
+			dst = w.appendNewline(dst, depth+1)
+		}
+		dst = appendItem(dst, i)
+	}
+	if pretty {
+		dst = w.appendNewline(dst, depth)
+	}
+	return append(dst, text.ClosingParenthesis...)
+}
+
+func (w *Writer) appendNewline(dst []byte, depth int) []byte {
+	dst = append(dst, '\n')
+	for i := 0; i < depth; i++ {
+		dst = append(dst, w.indent...)
+	}
+	return dst
+}
+
+// appendCoords appends space separated coordinates of point.
+// The original text of coordinates is used if it is kept by parser.
+func (w *Writer) appendCoords(dst []byte, p *geometry.Point) []byte {
+	var literal geometry.CoordinateText
+	if p.Text != nil {
+		literal = *p.Text
+	}
+
+	dst = w.appendNumber(dst, p.X, literal.X)
+	dst = append(dst, ' ')
+	dst = w.appendNumber(dst, p.Y, literal.Y)
+	if p.Type.HasZ() {
+		dst = append(dst, ' ')
+		dst = w.appendNumber(dst, p.Z, literal.Z)
+	}
+	if w.nullZ(p.Type) {
+		dst = append(dst, ' ')
+		dst = w.appendKeyword(dst, string(text.Null))
+	}
+	if p.Type.HasM() {
+		dst = append(dst, ' ')
+		dst = w.appendNumber(dst, p.M, literal.M)
+	}
+	return dst
+}
+
+// appendKeyword appends keyword in the case of the dialect
+func (w *Writer) appendKeyword(dst []byte, keyword string) []byte {
+	if !w.dialect.WriteLowercase {
+		return append(dst, keyword...)
+	}
+	for i := 0; i < len(keyword); i++ {
+		c := keyword[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
@@ -0,0 +1,375 @@
+package writer_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/IvanZagoskin/wkt/geometry"
+	"github.com/IvanZagoskin/wkt/parser"
+	"github.com/IvanZagoskin/wkt/text"
+	"github.com/IvanZagoskin/wkt/writer"
+)
+
+func TestMarshal_RoundTrip(t *testing.T) {
+	testCases := []string{
+		"POINT (30 20)",
+		"POINT Z (30.2 20.7 34.777)",
+		"POINT M (30.2 20.7 34.777)",
+		"POINT ZM (30.2 -20.7 34.777 63.23)",
+		"POINT EMPTY",
+		"MULTIPOINT (30 20, 40 50)",
+		"MULTIPOINT Z (30.2 20.7 34.777, 10.2 50.7 64.777)",
+		"MULTIPOINT EMPTY",
+		"LINESTRING (30 10, 10 30, 40 40)",
+		"LINESTRING M (30.123 10.15 11.22, 10.66 30.23 22.33)",
+		"LINESTRING EMPTY",
+		"CIRCULARSTRING ZM (1 0 1 2, 0 1 1 2, -1 0 1 2)",
+		"CIRCULARSTRING EMPTY",
+		"MULTILINESTRING ((10 10, 20 20, 10 40), (40 40, 30 30, 40 20, 30 10))",
+		"MULTILINESTRING EMPTY",
+		"POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+		"POLYGON Z ((0 0 1, 1 0 1, 1 1 1, 0 0 1))",
+		"POLYGON EMPTY",
+		"MULTIPOLYGON (((40 40, 20 45, 45 30, 40 40)), ((20 35, 10 30, 10 10, 30 5, 45 20, 20 35), (30 20, 20 15, 20 25, 30 20)))",
+		"MULTIPOLYGON EMPTY",
+	}
+
+	wktParser := parser.New()
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc, func(t *testing.T) {
+			geom, err := wktParser.ParseWKT(bytes.NewReader([]byte(tc)))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			wkt, err := writer.Marshal(geom)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(string(wkt), tc); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+
+			parsed, err := wktParser.ParseWKT(bytes.NewReader(wkt))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(parsed, geom); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Geometry geometry.Geometry
+		Expected string
+		Error    error
+	}{
+		{
+			Name:     "Shortest float",
+			Geometry: &geometry.Point{X: 0.1, Y: 1e21, Type: geometry.XY},
+			Expected: "POINT (0.1 1000000000000000000000)",
+		},
+		{
+			Name: "Kept coordinate text",
+			Geometry: &geometry.Point{
+				X: 0.1, Y: 20, Z: 3, Type: geometry.XYZ,
+				Text: &geometry.CoordinateText{X: "0.10", Y: "20.000000000000000001", Z: ""},
+			},
+			Expected: "POINT Z (0.10 20.000000000000000001 3)",
+		},
+		{
+			Name: "Edited coordinate with kept text",
+			Geometry: &geometry.Point{
+				X: 0.5, Y: 20, Type: geometry.XY,
+				Text: &geometry.CoordinateText{X: "0.10", Y: "20.0"},
+			},
+			Expected: "POINT (0.5 20.0)",
+		},
+		{
+			Name:     "NaN coordinate",
+			Geometry: &geometry.Point{X: math.NaN(), Y: 2, Type: geometry.XY},
+			Error:    writer.ErrInvalidCoordinate,
+		},
+		{
+			Name:     "Infinite coordinate",
+			Geometry: &geometry.LineString{Type: geometry.XYM, Points: []*geometry.Point{{X: 1, Y: 2, M: math.Inf(1), Type: geometry.XYM}}},
+			Error:    writer.ErrInvalidCoordinate,
+		},
+		{
+			Name: "Empty point of multipoint",
+			Geometry: &geometry.MultiPoint{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {Type: geometry.Empty}},
+			},
+			Error: writer.ErrEmptyPart,
+		},
+		{
+			Name: "Empty polygon of multipolygon",
+			Geometry: &geometry.MultiPolygon{
+				Type:     geometry.XY,
+				Polygons: []*geometry.Polygon{{Type: geometry.XY}},
+			},
+			Error: writer.ErrEmptyPart,
+		},
+		{
+			Name: "Mixed coordinate types",
+			Geometry: &geometry.MultiPoint{
+				Type:   geometry.XY,
+				Points: []*geometry.Point{{X: 1, Y: 2, Type: geometry.XY}, {X: 1, Y: 2, Z: 3, Type: geometry.XYZ}},
+			},
+			Error: writer.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:     "Unknown coordinate type",
+			Geometry: &geometry.Point{X: 1, Y: 2},
+			Error:    writer.ErrUnexpectedCoordinateType,
+		},
+		{
+			Name:     "Nil referenced",
+			Geometry: (*geometry.Referenced)(nil),
+			Error:    writer.ErrUnsupportedGeometry,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			wkt, err := writer.Marshal(tc.Geometry)
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(string(wkt), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshal_KeepCoordinateText(t *testing.T) {
+	input := "LINESTRING ZM (0.1000 -20.00000000000000000001 1e3 0, 1.0 2.0 3.0 4.0)"
+	geom, err := parser.New(parser.KeepCoordinateText()).ParseWKT(bytes.NewReader([]byte(input)))
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	wkt, err := writer.Marshal(geom)
+	if err != nil {
+		t.Fatalf("\nunexpected error:%v\n\n", err)
+	}
+
+	if diff := cmp.Diff(string(wkt), input); diff != "" {
+		t.Fatal("\n-want +got\n", diff)
+	}
+}
+
+func TestMarshal_Options(t *testing.T) {
+	ewkt := text.PostGIS()
+	ewkt.WriteImplicitDimension = true
+
+	polygon := "MULTIPOLYGON (((40 40, 20 45, 45 30, 40 40)), ((20 35, 10 30, 10 10, 30 5, 45 20, 20 35), (30 20, 20 15, 20 25, 30 20)))"
+
+	testCases := []struct {
+		Name     string
+		Wkt      string
+		Options  []writer.Option
+		Expected string
+	}{
+		{
+			Name:     "Fixed precision",
+			Wkt:      "POINT (1.23456 2)",
+			Options:  []writer.Option{writer.Precision(2)},
+			Expected: "POINT (1.23 2.00)",
+		},
+		{
+			Name:     "Max precision",
+			Wkt:      "LINESTRING (1.23456 2, 0.1 -0.0001)",
+			Options:  []writer.Option{writer.MaxPrecision(3)},
+			Expected: "LINESTRING (1.235 2, 0.1 0)",
+		},
+		{
+			Name:     "Trim zeros of kept text",
+			Wkt:      "POINT (1.500 2.0)",
+			Options:  []writer.Option{writer.TrimZeros()},
+			Expected: "POINT (1.5 2)",
+		},
+		{
+			Name:     "Shortest float resets precision",
+			Wkt:      "POINT (1.23456 2)",
+			Options:  []writer.Option{writer.Precision(2), writer.ShortestFloat()},
+			Expected: "POINT (1.23456 2)",
+		},
+		{
+			Name:     "Compact",
+			Wkt:      "MULTILINESTRING Z ((1 2 3, 4 5 6), (7 8 9, 1 2 3))",
+			Options:  []writer.Option{writer.Compact()},
+			Expected: "MULTILINESTRING Z ((1 2 3,4 5 6),(7 8 9,1 2 3))",
+		},
+		{
+			Name:    "Pretty print",
+			Wkt:     polygon,
+			Options: []writer.Option{writer.Indent("  ")},
+			Expected: `MULTIPOLYGON (
+  (
+    (40 40, 20 45, 45 30, 40 40)
+  ),
+  (
+    (20 35, 10 30, 10 10, 30 5, 45 20, 20 35),
+    (30 20, 20 15, 20 25, 30 20)
+  )
+)`,
+		},
+		{
+			Name:     "Pretty print of line",
+			Wkt:      "LINESTRING (30 10, 10 30)",
+			Options:  []writer.Option{writer.Indent("\t")},
+			Expected: "LINESTRING (30 10, 10 30)",
+		},
+		{
+			Name:     "Lowercase",
+			Wkt:      "POINT EMPTY",
+			Options:  []writer.Option{writer.WithDialect(text.Dialect{WriteLowercase: true})},
+			Expected: "point empty",
+		},
+		{
+			Name:     "PostGIS",
+			Wkt:      "MULTIPOINT Z (1 2 3, 4 5 6)",
+			Options:  []writer.Option{writer.WithDialect(text.PostGIS())},
+			Expected: "MULTIPOINT Z ((1 2 3),(4 5 6))",
+		},
+		{
+			Name:     "PostGIS implicit dimension",
+			Wkt:      "POINT M (1 2 3)",
+			Options:  []writer.Option{writer.WithDialect(ewkt)},
+			Expected: "POINTM(1 2 3)",
+		},
+		{
+			Name:     "SQL Server",
+			Wkt:      "LINESTRING M (1 2 3, 4 5 6)",
+			Options:  []writer.Option{writer.WithDialect(text.SQLServer())},
+			Expected: "LINESTRING (1 2 NULL 3, 4 5 NULL 6)",
+		},
+		{
+			Name:     "Oracle",
+			Wkt:      "POINT ZM (1 2 3 4)",
+			Options:  []writer.Option{writer.WithDialect(text.Oracle())},
+			Expected: "POINT (1 2 3 4)",
+		},
+		{
+			Name:     "MySQL",
+			Wkt:      "POLYGON ((0 0, 1 0, 1 1, 0 0))",
+			Options:  []writer.Option{writer.WithDialect(text.MySQL())},
+			Expected: "POLYGON((0 0,1 0,1 1,0 0))",
+		},
+	}
+
+	wktParser := parser.New(parser.KeepCoordinateText())
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			geom, err := wktParser.ParseWKT(bytes.NewReader([]byte(tc.Wkt)))
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			wkt, err := writer.New(tc.Options...).Marshal(geom)
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(string(wkt), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}
+
+func TestMarshal_DialectRoundTrip(t *testing.T) {
+	dialects := []text.Dialect{text.PostGIS(), text.Oracle(), text.SQLServer(), text.MySQL(), text.Shapely()}
+	inputs := []string{
+		"MULTIPOINT Z (30.2 20.7 34.777, 10.2 50.7 64.777)",
+		"POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+	}
+
+	for _, d := range dialects {
+		for _, input := range inputs {
+			d, input := d, input
+			t.Run(d.Name+" "+input, func(t *testing.T) {
+				geom, err := parser.New().ParseWKT(bytes.NewReader([]byte(input)))
+				if err != nil {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+
+				wkt, err := writer.New(writer.WithDialect(d)).Marshal(geom)
+				if err != nil {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+
+				parsed, err := parser.New(parser.WithDialect(d)).ParseWKT(bytes.NewReader(wkt))
+				if err != nil {
+					t.Fatalf("\nunexpected error:%v\n\n", err)
+				}
+
+				if diff := cmp.Diff(parsed, geom); diff != "" {
+					t.Fatal("\n-want +got\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestMarshal_DialectPointM(t *testing.T) {
+	testCases := []struct {
+		Dialect  text.Dialect
+		Expected string
+		Error    error
+	}{
+		{Dialect: text.Dialect{Name: "strict"}, Expected: "POINT M (1 2 3)"},
+		{Dialect: text.PostGIS(), Expected: "POINT M (1 2 3)"},
+		{Dialect: text.EWKT(), Expected: "POINTM(1 2 3)"},
+		{Dialect: text.Oracle(), Error: writer.ErrUnexpectedCoordinateType},
+		{Dialect: text.SQLServer(), Expected: "POINT (1 2 NULL 3)"},
+		{Dialect: text.MySQL(), Expected: "POINT M (1 2 3)"},
+		{Dialect: text.Shapely(), Expected: "POINT M (1 2 3)"},
+	}
+
+	point := &geometry.Point{X: 1, Y: 2, M: 3, Type: geometry.XYM}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Dialect.Name, func(t *testing.T) {
+			wkt, err := writer.New(writer.WithDialect(tc.Dialect)).Marshal(point)
+			if tc.Error != nil {
+				if !errors.Is(err, tc.Error) {
+					t.Fatalf("\ngot: %v\nexpected error: %s\n", err, tc.Error)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("\nunexpected error:%v\n\n", err)
+			}
+
+			if diff := cmp.Diff(string(wkt), tc.Expected); diff != "" {
+				t.Fatal("\n-want +got\n", diff)
+			}
+		})
+	}
+}